@@ -66,6 +66,7 @@ REFERENCE=$4
 mkdir ../mm-$REPO-$VERSION-$COMMAND
 cp -rp ./. ../mm-$REPO-$VERSION-$COMMAND
 pushd ../mm-$REPO-$VERSION-$COMMAND
+MM_LOCAL_PATH=$(pwd)
 
 clone_repo
 
@@ -93,6 +94,11 @@ fi
 if [ "$REPO" == "terraform" ]; then
     pushd $LOCAL_PATH
     go mod download
+    if [ "$COMMAND" == "downstream" ]; then
+        # Keep the previous provider code around to report schema changes against
+        mkdir -p "${LOCAL_PATH}old"
+        cp -r $LOCAL_PATH/. "${LOCAL_PATH}old"
+    fi
     find . -type f -not -wholename "./.git*" -not -wholename "./.changelog*" -not -name ".travis.yml" -not -name ".golangci.yml" -not -name "CHANGELOG.md" -not -name "GNUmakefile" -not -name "docscheck.sh" -not -name "LICENSE" -not -name "README.md" -not -wholename "./examples*" -not -name ".go-version" -not -name ".hashibot.hcl" -not -name "tools.go"  -exec git rm {} \;
     popd
 fi
@@ -174,6 +180,21 @@ if [ "$REPO" == "terraform" ]; then
     make generate
 fi
 
+if [ "$REPO" == "terraform" ] && [ "$COMMAND" == "downstream" ]; then
+    if [ "$VERSION" == "ga" ]; then
+        PROVIDER_NAME=google
+    else
+        PROVIDER_NAME=google-beta
+    fi
+    pushd $MM_LOCAL_PATH/tools/breaking-change-detector
+    sed -i.bak -E "s~google/provider/(.*)/([0-9A-Za-z-]*)~google/provider/\1/$PROVIDER_NAME~" comparison.go
+    go mod edit -replace google/provider/new=$(realpath $LOCAL_PATH)
+    go mod edit -replace google/provider/old=$(realpath ${LOCAL_PATH}old)
+    go mod tidy
+    go run . -report -providerVersion=$PROVIDER_NAME -providerFolder="${LOCAL_PATH}/.github/"
+    popd
+fi
+
 git config --local user.name "Modular Magician"
 git config --local user.email "magic-modules@google.com"
 git add .
@@ -312,6 +312,16 @@ module Api
       nil
     end
 
+    # Recurses through all nested properties and parameters and applies their
+    # deprecation schedule (deprecated_in/removed_in) for a provider major
+    # version.
+    def apply_deprecation_schedule!(major_version)
+      @properties&.each { |p| p.apply_deprecation_schedule!(major_version) }
+      @parameters&.each { |p| p.apply_deprecation_schedule!(major_version) }
+
+      nil
+    end
+
     # ====================
     # URL-related methods
     # ====================
@@ -44,6 +44,14 @@ module Api
       # }
       attr_reader :modify_by_patch
 
+      # The name of the parent resource's field that changes whenever it's
+      # modified, such as fingerprint or etag. If set with modify_by_patch,
+      # its value is read along with the list and sent back with the modified
+      # list, so the parent rejects the request if another client modified it
+      # in between. The list is then read, modified and sent again.
+      # The field is expected at the top level of the parent resource.
+      attr_reader :fingerprint_name
+
       # Nested resources generally don't have a kind field.
       # This is used as a (potentially unnecessary) placeholder by Ansible
       attr_reader :kind
@@ -54,6 +62,9 @@ module Api
         check :keys, type: Array, item_type: String, required: true
         check :is_list_of_ids, type: :boolean, default: false
         check :modify_by_patch, type: :boolean, default: false
+        check :fingerprint_name, type: String
+        raise ':fingerprint_name requires :modify_by_patch = true' \
+          if @fingerprint_name && !@modify_by_patch
 
         check :kind, type: String
       end
@@ -36,6 +36,16 @@ module Api
       # a different version.
       attr_reader :removed_message
 
+      # The provider major version, e.g. "5.0.0", from which the field is
+      # deprecated. A deprecation message is generated for it unless
+      # deprecation_message is set. It defaults to the major version before
+      # removed_in.
+      attr_reader :deprecated_in
+
+      # The provider major version, e.g. "6.0.0", from which the field is no
+      # longer generated.
+      attr_reader :removed_in
+
       attr_reader :output # If set value will not be sent to server on sync
       attr_reader :input # If set to true value is used only on creation
 
@@ -112,6 +122,13 @@ module Api
       check :exclude, type: :boolean, default: false, required: true
       check :deprecation_message, type: ::String
       check :removed_message, type: ::String
+      check :deprecated_in, type: ::String
+      check :removed_in, type: ::String
+      [@deprecated_in, @removed_in].compact.each do |v|
+        raise "#{@name}: #{v} isn't a major version such as 5.0.0" unless v =~ /\A\d+\.0\.0\z/
+      end
+      raise "#{@name}: deprecated_in must be before removed_in" \
+        if @deprecated_in && @removed_in && @deprecated_in.to_i >= @removed_in.to_i
       check :min_version, type: ::String
       check :exact_version, type: ::String
       check :output, type: :boolean
@@ -330,6 +347,33 @@ module Api
       !(@deprecation_message.nil? || @deprecation_message == '')
     end
 
+    # Returns true if the field isn't generated at a provider major version.
+    def removed_at?(major_version)
+      !@removed_in.nil? && major_version >= @removed_in.to_i
+    end
+
+    # Returns true if the field is deprecated at a provider major version.
+    def deprecated_at?(major_version)
+      deprecated_from = @deprecated_in&.to_i || (@removed_in.to_i - 1 unless @removed_in.nil?)
+      !deprecated_from.nil? && major_version >= deprecated_from
+    end
+
+    # Applies the deprecation schedule of the field for a provider major
+    # version: it's excluded once removed, and gets a deprecation message once
+    # deprecated.
+    def apply_deprecation_schedule!(major_version)
+      if removed_at?(major_version)
+        @exclude = true
+      elsif deprecated_at?(major_version) && !deprecated?
+        @deprecation_message = scheduled_deprecation_message
+      end
+    end
+
+    def scheduled_deprecation_message
+      when_removed = @removed_in.nil? ? 'a future major release' : "the #{@removed_in} release"
+      "`#{name.underscore}` is deprecated and will be removed in #{when_removed}."
+    end
+
     private
 
     # A constant value to be provided as field
@@ -457,6 +501,12 @@ module Api
           if @item_type.is_a? NestedObject
       end
 
+      def apply_deprecation_schedule!(major_version)
+        super
+        @item_type.apply_deprecation_schedule!(major_version) \
+          if @item_type.is_a? NestedObject
+      end
+
       def nested_properties
         return @item_type.nested_properties.reject(&:exclude) \
           if @item_type.is_a?(Api::Type::NestedObject)
@@ -618,6 +668,11 @@ module Api
         super
         @properties.each { |p| p.exclude_if_not_in_version!(version) }
       end
+
+      def apply_deprecation_schedule!(major_version)
+        super
+        @properties.each { |p| p.apply_deprecation_schedule!(major_version) }
+      end
     end
 
     # An array of string -> string key -> value pairs, such as labels.
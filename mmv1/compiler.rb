@@ -47,6 +47,7 @@ provider_name = nil
 force_provider = nil
 types_to_generate = []
 version = 'ga'
+provider_version = nil
 override_dir = nil
 
 ARGV << '-h' if ARGV.empty?
@@ -78,6 +79,9 @@ OptionParser.new do |opt|
   opt.on('-v', '--version VERSION', 'API version to generate') do |v|
     version = v
   end
+  opt.on('-m', '--provider-version VERSION', 'Version of the generated provider, e.g. 4.80.0') do |m|
+    provider_version = m
+  end
   opt.on('-r', '--override OVERRIDE', 'Directory containing api.yaml overrides') do |r|
     override_dir = r
   end
@@ -215,6 +219,8 @@ all_product_files.each do |product_name|
       override_providers[force_provider].new(provider_config, product_api, version, start_time)
   end
 
+  provider.provider_version = provider_version
+
   # provider_config is mutated by instantiating a provider
   products_for_version.push(definitions: product_api, overrides: provider_config)
 
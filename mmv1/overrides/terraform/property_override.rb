@@ -46,6 +46,12 @@ module Overrides
           # Does not set this value to the returned API value.  Useful for fields
           # like secrets where the returned API value is not helpful.
           :ignore_read,
+          # Never stores this value in the state. The value is read from the
+          # configuration when it's sent to the API, and changes to it are
+          # ignored. A `<name>_wo_version` field is added next to it, and
+          # changing that sends the current value again. Implies `sensitive`.
+          # Only supported on Strings outside of Arrays.
+          :write_only,
           :validation, # Adds a ValidateFunc to the schema
           # Indicates that this is an Array that should have Set diff semantics.
           :unordered_list,
@@ -85,6 +91,15 @@ module Overrides
           # For a TypeMap, the DSF to apply to the key.
           :key_diff_suppress_func,
 
+          # The previous Terraform name of a top-level field that was renamed.
+          # Generates a schema version bump and a state upgrader that moves the
+          # value from the old name to the new one, so existing state (including
+          # ForceNew fields) doesn't produce a diff after the rename. Only one
+          # generated rename upgrade is supported per resource; fold it into a
+          # handwritten state migration before renaming more fields in a later
+          # release.
+          :renamed_from,
+
           # ====================
           # Schema Modifications
           # ====================
@@ -148,6 +163,7 @@ module Overrides
         super
 
         check :sensitive, type: :boolean, default: false
+        check :write_only, type: :boolean, default: false
         check :is_set, type: :boolean, default: false
         check :default_from_api, type: :boolean, default: false
         check :unordered_list, type: :boolean, default: false
@@ -156,6 +172,7 @@ module Overrides
         # technically set as a default everywhere, but only maps will use this.
         check :key_expander, type: String, default: 'expandString'
         check :key_diff_suppress_func, type: String
+        check :renamed_from, type: String
 
         check :diff_suppress_func, type: String
         check :state_func, type: String
@@ -175,6 +192,12 @@ module Overrides
                                        api_property.description)
         end
 
+        if @write_only && !api_property.is_a?(Api::Type::String)
+          raise 'Only Strings can be write_only. Type is ' \
+                "#{api_property.class} for property #{api_property.name}"
+        end
+        @sensitive = true if @write_only
+
         if @flatten_object && !api_property.is_a?(Api::Type::NestedObject)
           raise 'Only NestedObjects can be flattened with flatten_object. Type'\
             " is #{api_property.class} for property #{api_property.name}"
@@ -15,6 +15,7 @@ require 'overrides/resources'
 require 'provider/terraform/custom_code'
 require 'provider/terraform/docs'
 require 'provider/terraform/examples'
+require 'provider/terraform/sweeper'
 require 'provider/terraform/virtual_fields'
 
 module Overrides
@@ -81,6 +82,27 @@ module Overrides
           # If true, skip sweeper generation for this resource
           :skip_sweeper,
 
+          # Settings for the generated sweeper, such as parent resources and
+          # sweepers to run first. See provider/terraform/sweeper.rb
+          :sweeper,
+
+          # If true, also generate a plural data source, e.g.
+          # `google_<product>_<resource>s`, that lists the resource's
+          # collection using the resource's flatteners.
+          :list_data_source,
+          # Set to false if the List method of the resource doesn't support
+          # filtering, to not add a filter argument to its list data source.
+          :list_data_source_filter,
+          # If true, the zone or region argument of the list data source of a
+          # zonal or regional resource is optional. When it isn't set, the
+          # data source lists the resources of every zone or region with the
+          # resource's aggregatedList method.
+          :list_data_source_aggregated,
+
+          # If true, also generate a singular data source with the name of the
+          # resource that reads one existing resource by its identity.
+          :data_source,
+
           # Set to true for resources that are unable to be deleted, such as KMS keyrings or project
           # level resources such as firebase project
           :skip_delete,
@@ -94,6 +116,12 @@ module Overrides
           # an error and returns one.
           :read_error_transform,
 
+          # If true, read errors indicating that the parent of this resource was
+          # deleted (for example a node pool whose cluster is gone) are treated
+          # as a 404, and the resource is removed from state instead of failing
+          # the refresh. Applied after read_error_transform, if one is set.
+          :read_error_parent_not_found,
+
           # If true, resources that failed creation will be marked as tainted. As a consequence
           # these resources will be deleted and recreated on the next apply call. This pattern
           # is preferred over deleting the resource directly in post_create_failure hooks.
@@ -129,9 +157,16 @@ module Overrides
         check :error_retry_predicates, type: Array, item_type: String
         check :schema_version, type: Integer
         check :skip_sweeper, type: :boolean, default: false
+        check :sweeper, type: Provider::Terraform::Sweeper,
+                        default: Provider::Terraform::Sweeper.new
+        check :list_data_source, type: :boolean, default: false
+        check :list_data_source_filter, type: :boolean, default: true
+        check :list_data_source_aggregated, type: :boolean, default: false
+        check :data_source, type: :boolean, default: false
         check :skip_delete, type: :boolean, default: false
         check :supports_indirect_user_project_override, type: :boolean, default: false
         check :read_error_transform, type: String
+        check :read_error_parent_not_found, type: :boolean, default: false
         check :taint_resource_on_failed_create, type: :boolean, default: false
       end
 
@@ -49,6 +49,15 @@ module Provider
       @go_format_enabled = check_goformat
     end
 
+    # The version of the generated provider, e.g. 4.80.0, if one was given.
+    attr_accessor :provider_version
+
+    # The major version of the generated provider, used to apply the
+    # deprecation schedule of fields. nil if the provider isn't versioned.
+    def major_version
+      nil
+    end
+
     # This provides the ProductFileTemplate class with access to a provider.
     def provider_binding
       binding
@@ -205,6 +214,9 @@ module Provider
           # exclude_if_not_in_version must be called in order to filter out
           # beta properties that are nested within GA resources
           object.exclude_if_not_in_version!(@version)
+          # The deprecation schedule of fields depends on the major version of
+          # the generated provider, if it has one.
+          object.apply_deprecation_schedule!(major_version) unless major_version.nil?
 
           # Make object immutable.
           object.freeze
@@ -44,6 +44,41 @@ module Provider
       attr_accessor :resource_name
     end
 
+    # The major version of the provider being generated, read from
+    # --provider-version. Fields with a removed_in at or below it are no
+    # longer generated, and fields due for deprecation get a deprecation
+    # message. nil if no provider version was given.
+    def major_version
+      return nil if provider_version.nil?
+
+      major = provider_version.delete_prefix('v').split('.').first
+      raise "Invalid provider version #{provider_version}" unless major =~ /\A\d+\z/
+
+      major.to_i
+    end
+
+    # Whether handwritten resources use the shared labels model, where the
+    # labels field is non-authoritative. It's a breaking change, so the model
+    # is only used from the 5.0.0 release on.
+    def shared_labels_model?
+      !major_version.nil? && major_version >= 5
+    end
+
+    # Matches the zones/{{zone}} or regions/{{region}} segment of the list URL
+    # of a zonal or regional resource.
+    AGGREGATED_LIST_SCOPE_REGEX = %r{/(zones|regions)/\{\{(?<scope>zone|region)\}\}/}.freeze
+
+    # Returns the fields of properties and their nested properties that are
+    # scheduled for removal in a later major version, as [path, property]
+    # pairs with dotted paths, e.g. "parent.child".
+    def scheduled_removals(properties, version, prefix = '')
+      properties.reject { |p| p.exclude || version < p.min_version }.flat_map do |prop|
+        path = "#{prefix}#{prop.name.underscore}"
+        own = prop.removed_in.nil? ? [] : [[path, prop]]
+        own + scheduled_removals(prop.nested_properties || [], version, "#{path}.")
+      end
+    end
+
     # Sorts properties in the order they should appear in the TF schema:
     # Required, Optional, Computed
     def order_properties(properties)
@@ -93,6 +128,20 @@ module Provider
       !resource.input || !properties.reject { |p| p.update_url.nil? }.empty?
     end
 
+    # Top-level properties whose Terraform name changed, and whose value needs
+    # to be moved by a generated state upgrader.
+    def renamed_properties(object)
+      object.all_user_properties.reject(&:flatten_object).select(&:renamed_from)
+    end
+
+    # The schema version of the resource, counting handwritten state
+    # migrations plus the generated upgrader for renamed fields, if any.
+    def schema_version(object)
+      version = object.schema_version || 0
+      version += 1 unless renamed_properties(object).empty?
+      version.zero? ? nil : version
+    end
+
     def force_new?(property, resource)
       !property.output &&
         (property.input || (resource.input && property.update_url.nil? && property.input.nil? &&
@@ -100,6 +149,35 @@ module Provider
                              force_new?(property.parent, resource))))
     end
 
+    # The write-only properties of the resource, including nested ones.
+    def write_only_properties(object)
+      object.all_nested_properties(object.all_user_properties).select(&:write_only)
+    end
+
+    # The Terraform name of the field that triggers sending a write-only
+    # property again, e.g. `password_wo_version` for `password_wo` or
+    # `password`.
+    def write_only_version_name(property)
+      name = property.name.underscore
+      name.end_with?('_wo') ? "#{name}_version" : "#{name}_wo_version"
+    end
+
+    # The schema path of a write-only property (e.g. 'parent_field.0.child_wo'),
+    # or of its version field if version is true.
+    def write_only_schema_path(property, version: false)
+      path = []
+      prop = property
+      until prop.nil?
+        raise "write_only isn't supported inside Arrays (#{property.lineage})" \
+          if prop.parent.is_a?(Api::Type::Array)
+
+        path.unshift(prop.name.underscore) unless prop.flatten_object
+        prop = prop.parent
+      end
+      path[-1] = write_only_version_name(property) if version
+      path.join('.0.')
+    end
+
     # Returns tuples of (fieldName, list of update masks) for
     #  top-level updatable fields. Schema path refers to a given Terraform
     # field name (e.g. d.GetChange('fieldName)')
@@ -174,6 +252,196 @@ module Provider
       property.name.camelize(:upper)
     end
 
+    # Returns true if a plural data source listing the resource's collection
+    # is generated alongside the resource. The data source reuses the SDK
+    # resource's schema and flatteners, and reads the collection with a plain
+    # List call, so resources without those don't get one.
+    def list_data_source?(object, config = @config)
+      object.list_data_source && !object.exclude_resource &&
+        !framework_resource?(object, config) &&
+        object.nested_query.nil? && object.read_verb == :GET
+    end
+
+    # The name of the plural data source of a resource, e.g.
+    # google_securesourcemanager_repositories.
+    def list_data_source_name(object, config = @config)
+      tf_product = (config.legacy_name || object.__product.name).underscore
+      (object.legacy_name || "google_#{tf_product}_#{object.name.underscore}").pluralize
+    end
+
+    # The zone or region variable of the list URL of a resource whose list
+    # data source reads the aggregatedList of the resource when it isn't set,
+    # or nil if the list data source doesn't read the aggregatedList.
+    def aggregated_list_scope(object)
+      return unless object.list_data_source_aggregated
+
+      match = object.base_url.match(AGGREGATED_LIST_SCOPE_REGEX)
+      if match.nil?
+        raise "#{object.name} has list_data_source_aggregated set, but its base_url " \
+              "#{object.base_url} has no zones/{{zone}} or regions/{{region}} segment"
+      end
+
+      match[:scope]
+    end
+
+    # The aggregatedList URL of a resource, e.g.
+    # projects/{{project}}/aggregated/disks for
+    # projects/{{project}}/zones/{{zone}}/disks.
+    def aggregated_list_url(object)
+      object.base_url.sub(AGGREGATED_LIST_SCOPE_REGEX, '/aggregated/')
+    end
+
+    # The key of the items of each scope of an aggregatedList response, which
+    # is the last segment of the list URL, e.g. disks.
+    def aggregated_list_key(object)
+      object.base_url.split('/').last
+    end
+
+    # Returns true if a singular data source reading one resource by its
+    # identity is generated alongside the resource. The data source reuses
+    # the SDK resource's schema and Read, and every variable of the id format
+    # must be an argument of the resource.
+    def data_source?(object, config = @config)
+      object.data_source && !object.exclude_resource &&
+        !framework_resource?(object, config) &&
+        data_source_missing_arguments(object).empty?
+    end
+
+    # The variables of the id format of a resource, e.g. name and location,
+    # that identify the resource read by its singular data source. project,
+    # region and zone fall back to the provider's and are optional.
+    def data_source_identity(object)
+      id_format(object).scan(/{{%?(\w+)}}/).flatten.uniq
+                       .reject { |p| %w[project region zone].include?(p) }
+    end
+
+    # The variables of the id format that aren't arguments of the resource,
+    # and can't be arguments of its singular data source.
+    def data_source_missing_arguments(object)
+      data_source_identity(object).reject { |p| data_source_arguments(object).include?(p) }
+    end
+
+    # The optional arguments of the singular data source of a resource:
+    # project, and region or zone if they're arguments of the resource.
+    def data_source_optional_arguments(object)
+      id_format(object).scan(/{{%?(\w+)}}/).flatten.uniq.select do |p|
+        p == 'project' || (%w[region zone].include?(p) && data_source_arguments(object).include?(p))
+      end
+    end
+
+    def data_source_arguments(object)
+      object.all_user_properties.reject(&:output).map { |p| p.name.underscore }
+    end
+
+    # The name of the singular data source of a resource, the name of the
+    # resource, e.g. google_securesourcemanager_repository.
+    def data_source_name(object, config = @config)
+      tf_product = (config.legacy_name || object.__product.name).underscore
+      object.legacy_name || "google_#{tf_product}_#{object.name.underscore}"
+    end
+
+    # Returns true if the resource is generated as a plugin-framework resource
+    # rather than an SDK resource. The config defaults to the one of the
+    # product being generated, provider-level templates pass the config of
+    # the product the resource belongs to.
+    def framework_resource?(object, config = @config)
+      config.framework && framework_unsupported_features(object).empty?
+    end
+
+    # Returns the features of a resource that the plugin-framework generator
+    # doesn't support yet. Resources using any of them fall back to SDK
+    # resources.
+    def framework_unsupported_features(object)
+      features = []
+      %i[extra_schema_entry resource_definition encoder update_encoder decoder constants
+         pre_create post_create post_create_failure custom_create pre_read pre_update
+         post_update pre_delete post_delete custom_delete custom_import
+         post_import].each do |hook|
+        features << "custom_code.#{hook}" unless object.custom_code.send(hook).nil?
+      end
+      features << 'nested_query' if object.nested_query
+      features << 'mutex' if object.mutex
+      features << 'virtual_fields' unless object.virtual_fields.nil? || object.virtual_fields.empty?
+      features << 'error_retry_predicates' if object.error_retry_predicates
+      features << 'schema_version' if schema_version(object)
+      features << 'exclude_import' if object.exclude_import
+      features << 'supports_indirect_user_project_override' \
+        if object.supports_indirect_user_project_override
+      features << 'read_error_transform' if object.read_error_transform
+      features << 'read_error_parent_not_found' if object.read_error_parent_not_found
+      features << 'skip_delete' if object.skip_delete
+      features << 'read_verb' unless object.read_verb == :GET
+      features << 'read_query_params' if object.read_query_params
+      features << 'output identity fields' if object.identity.any?(&:output)
+      unless object.async.nil?
+        features << 'non-operation async' unless object.async.is_a?(Api::OpAsync)
+        features << 'partial async actions' \
+          unless %w[create update delete].all? { |a| object.async.allow?(a) }
+      end
+      features << 'field-specific update urls' \
+        unless object.all_user_properties.reject { |p| p.update_url.nil? }.empty?
+
+      framework_properties(object.all_user_properties).each do |prop|
+        features << "#{prop.class.name.split('::').last} field #{prop.name}" \
+          if [Api::Type::Map, Api::Type::ResourceRef].include?(prop.class) ||
+             (prop.is_a?(Api::Type::Array) &&
+              [Api::Type::Array, Api::Type::ResourceRef].include?(prop.item_type.class))
+        %i[custom_expand custom_flatten flatten_object diff_suppress_func state_func
+           default_value set_hash_func conflicting at_least_one_of_list exactly_one_of_list
+           required_with_list write_only].each do |attr|
+          value = prop.respond_to?(attr) ? prop.send(attr) : nil
+          features << "#{attr} on #{prop.name}" unless value.nil? || value == [] || value == false
+        end
+      end
+      features.uniq
+    end
+
+    # Returns the plugin-framework attribute type of a property, e.g. String
+    # for schema.StringAttribute.
+    def framework_attribute_type(property)
+      case property
+      when Api::Type::Boolean then 'Bool'
+      when Api::Type::Integer then 'Int64'
+      when Api::Type::Double then 'Float64'
+      when Api::Type::NestedObject then 'SingleNested'
+      when Api::Type::KeyValuePairs then 'Map'
+      when Api::Type::Array
+        collection = property.is_set ? 'Set' : 'List'
+        property.item_type.is_a?(Api::Type::NestedObject) ? "#{collection}Nested" : collection
+      else 'String'
+      end
+    end
+
+    # Returns the plan modifier type of a property, e.g. String for
+    # planmodifier.String and stringplanmodifier.
+    def framework_plan_modifier_type(property)
+      type = framework_attribute_type(property)
+      return 'Object' if type == 'SingleNested'
+
+      type.delete_suffix('Nested')
+    end
+
+    # Returns the element type of a List, Set or Map attribute of primitives.
+    def framework_element_type(property)
+      return 'types.StringType' if property.is_a?(Api::Type::KeyValuePairs)
+
+      item = property.item_type
+      item = Object.const_get(item) if item.is_a?(::String)
+      item = item.class unless item.is_a?(Class)
+      {
+        Api::Type::Boolean => 'types.BoolType',
+        Api::Type::Integer => 'types.Int64Type',
+        Api::Type::Double => 'types.Float64Type'
+      }.fetch(item, 'types.StringType')
+    end
+
+    # Returns the properties and all their nested properties.
+    def framework_properties(properties)
+      properties.flat_map do |prop|
+        [prop] + framework_properties(prop.nested_properties || [])
+      end
+    end
+
     private
 
     # Finds the folder name for a given version of the terraform provider
@@ -185,7 +453,18 @@ module Provider
     # per resource. The resource.erb template forms the basis of a single
     # GCP Resource on Terraform.
     def generate_resource(pwd, data, generate_code, generate_docs)
-      if generate_code
+      if generate_code && framework_resource?(data.object)
+        FileUtils.mkpath folder_name(data.version) unless Dir.exist?(folder_name(data.version))
+        data.generate(pwd,
+                      '/templates/terraform/framework/resource.go.erb',
+                      "#{folder_name(data.version)}/framework_resource_#{full_resource_name(data)}.go",
+                      self)
+      elsif generate_code
+        if @config.framework
+          Google::LOGGER.warn "Generating #{data.object.name} as an SDK resource, the " \
+                              'plugin-framework generator does not support: ' \
+                              "#{framework_unsupported_features(data.object).join(', ')}"
+        end
         FileUtils.mkpath folder_name(data.version) unless Dir.exist?(folder_name(data.version))
         data.generate(pwd,
                       '/templates/terraform/resource.erb',
@@ -193,11 +472,68 @@ module Provider
                       self)
       end
 
+      generate_list_data_source(pwd, data.clone, generate_code, generate_docs) \
+        if data.object.list_data_source
+      generate_data_source(pwd, data.clone, generate_code, generate_docs) \
+        if data.object.data_source
+
       return unless generate_docs
 
       generate_documentation(pwd, data)
     end
 
+    def generate_list_data_source(pwd, data, generate_code, generate_docs)
+      unless list_data_source?(data.object)
+        Google::LOGGER.warn "Skipping the list data source of #{data.object.name}, " \
+                            'it needs an SDK resource read with a plain GET'
+        return
+      end
+
+      name = list_data_source_name(data.object).sub(/^google_/, '')
+      if generate_code
+        FileUtils.mkpath folder_name(data.version) unless Dir.exist?(folder_name(data.version))
+        data.generate(pwd,
+                      '/templates/terraform/list_data_source.go.erb',
+                      "#{folder_name(data.version)}/data_source_#{name}.go",
+                      self)
+      end
+
+      return unless generate_docs
+
+      target_folder = File.join(data.output_folder, 'website', 'docs', 'd')
+      FileUtils.mkpath target_folder
+      data.generate(pwd,
+                    'templates/terraform/list_data_source.html.markdown.erb',
+                    File.join(target_folder, "#{name}.html.markdown"),
+                    self)
+    end
+
+    def generate_data_source(pwd, data, generate_code, generate_docs)
+      unless data_source?(data.object)
+        Google::LOGGER.warn "Skipping the data source of #{data.object.name}, it needs an " \
+                            'SDK resource whose id format only uses its arguments'
+        return
+      end
+
+      name = data_source_name(data.object).sub(/^google_/, '')
+      if generate_code
+        FileUtils.mkpath folder_name(data.version) unless Dir.exist?(folder_name(data.version))
+        data.generate(pwd,
+                      '/templates/terraform/data_source.go.erb',
+                      "#{folder_name(data.version)}/data_source_#{name}.go",
+                      self)
+      end
+
+      return unless generate_docs
+
+      target_folder = File.join(data.output_folder, 'website', 'docs', 'd')
+      FileUtils.mkpath target_folder
+      data.generate(pwd,
+                    'templates/terraform/data_source.html.markdown.erb',
+                    File.join(target_folder, "#{name}.html.markdown"),
+                    self)
+    end
+
     def generate_documentation(pwd, data)
       target_folder = data.output_folder
       target_folder = File.join(target_folder, 'website', 'docs', 'r')
@@ -207,6 +543,8 @@ module Provider
     end
 
     def generate_resource_tests(pwd, data)
+      generate_resource_state_upgrade_tests(pwd, data.clone)
+
       return if data.object.examples
                     .reject(&:skip_test)
                     .reject do |e|
@@ -224,6 +562,18 @@ module Provider
       )
     end
 
+    def generate_resource_state_upgrade_tests(pwd, data)
+      return if renamed_properties(data.object).empty?
+
+      FileUtils.mkpath folder_name(data.version) unless Dir.exist?(folder_name(data.version))
+      data.generate(
+        pwd,
+        'templates/terraform/state_migrations/renamed_fields_test.go.erb',
+        "#{folder_name(data.version)}/resource_#{full_resource_name(data)}_state_upgrade_generated_test.go",
+        self
+      )
+    end
+
     def generate_resource_sweepers(pwd, data)
       return if data.object.skip_sweeper ||
                 data.object.custom_code.custom_delete ||
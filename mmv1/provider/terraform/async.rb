@@ -21,9 +21,14 @@ module Provider
       # Details how to poll for an eventually-consistent resource state.
 
       # Function to call for checking the Poll response for
-      # creating and updating a resource
+      # creating and updating a resource. Not needed if `done` is set.
       attr_reader :check_response_func_existence
 
+      # A declarative check of the Poll response for creating and updating a
+      # resource, such as a state enum reaching a value, used instead of a
+      # handwritten check_response_func_existence.
+      attr_reader :done
+
       # Function to call for checking the Poll response for
       # deleting a resource
       attr_reader :check_response_func_absence
@@ -43,12 +48,75 @@ module Provider
       def validate
         super
 
-        check :check_response_func_existence, type: String, required: true
+        check :done, type: Done
+        check :check_response_func_existence, type: String, required: @done.nil?
+        if @done && @check_response_func_existence
+          raise 'Only one of check_response_func_existence and done can be set.'
+        end
         check :check_response_func_absence, type: String, default: 'PollCheckForAbsence'
         check :custom_poll_read, type: String
         check :suppress_error, type: :boolean, default: false
         check :target_occurrences, type: Integer, default: 1
       end
+
+      # The Go PollCheckResponseFunc checking the Poll response for creating
+      # and updating a resource.
+      def check_response_func_existence
+        @check_response_func_existence || @done&.check_response_func
+      end
+
+      # Describes when a resource is done being created or updated, from the
+      # resource itself rather than from an operation. Either a field, such as
+      # a state enum, reaches one of `values`, or a condition of a conditions
+      # array, such as a Knative "Ready" condition, has the status "True".
+      class Done < Api::Object
+        # The dot-separated path of the field in the resource, e.g. `state`,
+        # or of the conditions array, e.g. `status.conditions`.
+        attr_reader :path
+
+        # The values of the field once the resource is done.
+        attr_reader :values
+
+        # The values of the field that mean that the resource failed, which
+        # stop polling with an error.
+        attr_reader :failed_values
+
+        # The type of the condition to check in the conditions array at path,
+        # instead of checking the field's value.
+        attr_reader :condition_type
+
+        def validate
+          super
+
+          check :path, type: String, required: true
+          check :values, type: Array, item_type: String
+          check :failed_values, type: Array, item_type: String, default: []
+          check :condition_type, type: String
+
+          return if @values.nil? ^ @condition_type.nil?
+
+          raise "Exactly one of values and condition_type must be set for #{@path}."
+        end
+
+        def check_response_func
+          if @condition_type
+            "PollCheckForCondition(#{go_string(@path)}, #{go_string(@condition_type)})"
+          else
+            "PollCheckForFieldValue(#{go_string(@path)}, #{go_strings(@values)}, " \
+              "#{go_strings(@failed_values)})"
+          end
+        end
+
+        private
+
+        def go_string(value)
+          "\"#{value}\""
+        end
+
+        def go_strings(values)
+          "[]string{#{values.map { |v| go_string(v) }.join(', ')}}"
+        end
+      end
     end
   end
 end
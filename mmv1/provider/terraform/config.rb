@@ -18,6 +18,17 @@ module Provider
   class Terraform < Provider::AbstractCore
     # Settings for the provider
     class Config < Provider::Config
+      # If true, the resources of the product are generated as
+      # terraform-plugin-framework resources instead of SDK resources.
+      # Resources using features the framework generator doesn't support yet
+      # are still generated as SDK resources.
+      attr_reader :framework
+
+      def validate
+        super
+        check :framework, type: :boolean, default: false
+      end
+
       def provider
         Provider::Terraform
       end
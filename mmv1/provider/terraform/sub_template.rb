@@ -24,6 +24,20 @@ module Provider
                          pwd: pwd
       end
 
+      def build_framework_attribute(property, object, pwd, parent_output = false)
+        compile_template pwd + '/templates/terraform/framework/attribute.erb',
+                         property: property,
+                         object: object,
+                         parent_output: parent_output,
+                         pwd: pwd
+      end
+
+      def build_framework_field(property, pwd)
+        compile_template pwd + '/templates/terraform/framework/field.erb',
+                         property: property,
+                         pwd: pwd
+      end
+
       def build_subresource_schema(property, object, pwd)
         compile_template pwd + '/templates/terraform/schema_subresource.erb',
                          property: property,
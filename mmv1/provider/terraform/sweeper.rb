@@ -0,0 +1,87 @@
+# Copyright 2022 Google Inc.
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+require 'api/object'
+require 'provider/abstract_core'
+
+module Provider
+  class Terraform < Provider::AbstractCore
+    # Tunes the sweeper generated for a resource. Every generated resource
+    # gets a sweeper that lists the collection and deletes items whose name
+    # starts with one of the shared test prefixes; these settings cover
+    # resources that need more than that.
+    class Sweeper < Api::Object
+      # Lists a parent collection so that child resources, whose list URL
+      # contains a parent parameter, can be swept under every parent.
+      class Parent < Api::Object
+        # The list URL of the parent collection, relative to the product
+        # base URL. e.g. projects/{{project}}/locations/global/apis
+        attr_reader :list_url
+
+        # The key of the parent collection in the list response. Defaults to
+        # the last segment of list_url.
+        attr_reader :collection
+
+        # The URL parameter in the child's list and delete URLs that takes
+        # the short name of each parent, e.g. `api`.
+        attr_reader :param
+
+        def validate
+          super
+          check :list_url, type: String, required: true
+          check :param, type: String, required: true
+          check :collection, type: String, default: @list_url.split('/').last
+        end
+      end
+
+      # Names of sweepers that must run before this one, typically those of
+      # child resources that block deletion of this resource.
+      # e.g. ['ApiGatewayApiConfig']
+      attr_reader :dependencies
+
+      # Additional values to substitute in the list and delete URLs. The
+      # sweeper runs once per entry, which lets a resource be swept across
+      # several locations or well-known parents.
+      attr_reader :url_substitutions
+
+      attr_reader :parent
+
+      # The field of each listed item holding the value appended to the
+      # delete URL. Defaults to `name`, or `id` when the delete URL expects one.
+      attr_reader :identifier_field
+
+      # Name prefixes to sweep in addition to the shared test prefixes.
+      attr_reader :prefixes
+
+      # Labels that mark an item as a test resource regardless of its name.
+      # An item is swept if any of these key/value pairs is set on it.
+      attr_reader :labels
+
+      # Items for which a top-level field has one of these values are never
+      # swept, e.g. { 'state' => 'DELETING' } or the default instance of a
+      # resource that always exists.
+      attr_reader :skip_if
+
+      def validate
+        super
+        check :dependencies, type: Array, item_type: String, default: []
+        check :url_substitutions, type: Array, item_type: Hash, default: []
+        check :parent, type: Provider::Terraform::Sweeper::Parent
+        check :identifier_field, type: String
+        check :prefixes, type: Array, item_type: String, default: []
+        check :labels, type: Hash, default: {}
+        check :skip_if, type: Hash, default: {}
+      end
+    end
+  end
+end
@@ -143,6 +143,26 @@ describe Provider::Terraform do
       end
     end
 
+    describe '#renamed_properties' do
+      subject { provider.renamed_properties(override_resource).map(&:name) }
+      it { is_expected.to eq ['stringRenamed'] }
+    end
+
+    describe '#renamed_properties none' do
+      subject { provider.renamed_properties(override_product.objects[0]) }
+      it { is_expected.to be_empty }
+    end
+
+    describe '#schema_version renamed field' do
+      subject { provider.schema_version(override_resource) }
+      it { is_expected.to eq 1 }
+    end
+
+    describe '#schema_version no renamed field' do
+      subject { provider.schema_version(override_product.objects[0]) }
+      it { is_expected.to be_nil }
+    end
+
     describe '#get_property_update_masks_groups' do
       subject do
         provider.get_property_update_masks_groups(override_resource.properties)
@@ -0,0 +1,123 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/dns/v1"
+)
+
+func dataSourceDnsRecordSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsRecordSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"managed_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Restricts the list to record sets with this DNS name.`,
+			},
+
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Restricts the list to record sets of this type, such as "A" or "CNAME".`,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rrdatas": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDnsRecordSetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone := d.Get("managed_zone").(string)
+	name := d.Get("name").(string)
+	dnsType := d.Get("type").(string)
+
+	request := config.NewDnsClient(userAgent).ResourceRecordSets.List(project, zone)
+	if name != "" {
+		request = request.Name(name)
+		// The API only accepts a type filter along with a name filter, so
+		// record sets are filtered by type below otherwise.
+		if dnsType != "" {
+			request = request.Type(dnsType)
+		}
+	}
+
+	rrsets := make([]map[string]interface{}, 0)
+	err = request.Pages(config.context, func(resp *dns.ResourceRecordSetsListResponse) error {
+		for _, rrset := range resp.Rrsets {
+			if dnsType != "" && !strings.EqualFold(rrset.Type, dnsType) {
+				continue
+			}
+			rrsets = append(rrsets, map[string]interface{}{
+				"name":    rrset.Name,
+				"type":    rrset.Type,
+				"ttl":     rrset.Ttl,
+				"rrdatas": rrset.Rrdatas,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Record sets of managed zone %q", zone))
+	}
+
+	if err := d.Set("rrsets", rrsets); err != nil {
+		return fmt.Errorf("Error setting rrsets: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/managedZones/%s/rrsets", project, zone))
+
+	return nil
+}
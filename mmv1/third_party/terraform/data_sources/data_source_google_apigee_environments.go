@@ -0,0 +1,139 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleApigeeEnvironments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleApigeeEnvironmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The Apigee Organization to list the environments of, in the format 'organizations/{{org_name}}'.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The runtime state of the Apigee Organization, such as ACTIVE or CREATING.`,
+			},
+			"runtime_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The identifier of the environment, in the format {{org_id}}/environments/{{name}}.`,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The runtime state of the environment, such as ACTIVE or UPDATING.`,
+						},
+						"deployment_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_proxy_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleApigeeEnvironmentsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	orgId := d.Get("org_id").(string)
+	url, err := replaceVars(d, config, "{{ApigeeBasePath}}{{org_id}}")
+	if err != nil {
+		return err
+	}
+
+	// The environments list call returns a bare JSON array of names, so read
+	// them from the organization instead and fetch each environment in turn.
+	org, err := sendRequest(config, "GET", "", url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Apigee Organization %q", orgId))
+	}
+
+	environments := make([]map[string]interface{}, 0)
+	for _, name := range flattenDatasourceGoogleApigeeEnvironmentNames(org["environments"]) {
+		env, err := sendRequest(config, "GET", "", fmt.Sprintf("%s/environments/%s", url, name), userAgent, nil)
+		if err != nil {
+			return fmt.Errorf("Error reading Apigee Environment %q: %s", name, err)
+		}
+		environments = append(environments, flattenDatasourceGoogleApigeeEnvironment(orgId, env))
+	}
+
+	if err := d.Set("state", org["state"]); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
+	}
+	if err := d.Set("runtime_type", org["runtimeType"]); err != nil {
+		return fmt.Errorf("Error setting runtime_type: %s", err)
+	}
+	if err := d.Set("environments", environments); err != nil {
+		return fmt.Errorf("Error setting environments: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/environments", orgId))
+
+	return nil
+}
+
+func flattenDatasourceGoogleApigeeEnvironmentNames(v interface{}) []string {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(ls))
+	for _, raw := range ls {
+		if name, ok := raw.(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func flattenDatasourceGoogleApigeeEnvironment(orgId string, env map[string]interface{}) map[string]interface{} {
+	name, _ := env["name"].(string)
+	return map[string]interface{}{
+		"name":            name,
+		"id":              fmt.Sprintf("%s/environments/%s", orgId, name),
+		"display_name":    env["displayName"],
+		"description":     env["description"],
+		"state":           env["state"],
+		"deployment_type": env["deploymentType"],
+		"api_proxy_type":  env["apiProxyType"],
+	}
+}
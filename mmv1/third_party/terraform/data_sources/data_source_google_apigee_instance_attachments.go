@@ -0,0 +1,101 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleApigeeInstanceAttachments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleApigeeInstanceAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The Apigee instance to list the attachments of, in the format 'organizations/{{org_name}}/instances/{{name}}'.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The runtime state of the Apigee instance, such as ACTIVE or UPDATING.`,
+			},
+			"runtime_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the environment attached to the instance.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time the attachment was created, in milliseconds since the epoch.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleApigeeInstanceAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	instanceUrl, err := replaceVars(d, config, "{{ApigeeBasePath}}{{instance_id}}")
+	if err != nil {
+		return err
+	}
+
+	instance, err := sendRequest(config, "GET", "", instanceUrl, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Apigee Instance %q", instanceId))
+	}
+
+	attachments := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, "", instanceUrl+"/attachments", userAgent, nil, func(res map[string]interface{}) error {
+		items, _ := res["attachments"].([]interface{})
+		for _, raw := range items {
+			attachment := raw.(map[string]interface{})
+			attachments = append(attachments, map[string]interface{}{
+				"name":        attachment["name"],
+				"environment": attachment["environment"],
+				"created_at":  attachment["createdAt"],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Apigee instance attachments: %s", err)
+	}
+
+	if err := d.Set("state", instance["state"]); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
+	}
+	if err := d.Set("runtime_version", instance["runtimeVersion"]); err != nil {
+		return fmt.Errorf("Error setting runtime_version: %s", err)
+	}
+	if err := d.Set("attachments", attachments); err != nil {
+		return fmt.Errorf("Error setting attachments: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/attachments", instanceId))
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGoogleBigQueryTables() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleBigQueryTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the dataset containing the tables.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of tables to return. All tables in the dataset are returned if unset.`,
+			},
+			"tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The identifier of the table, in the format projects/{{project}}/datasets/{{dataset_id}}/tables/{{table_id}}.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the table, such as TABLE, VIEW, MATERIALIZED_VIEW, EXTERNAL or SNAPSHOT.`,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The time when the table was created, in milliseconds since the epoch.`,
+						},
+						"expiration_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The time when the table expires, in milliseconds since the epoch. 0 if the table does not expire.`,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleBigQueryTablesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{BigQueryBasePath}}projects/{{project}}/datasets/{{dataset_id}}/tables")
+	if err != nil {
+		return err
+	}
+
+	maxResults := d.Get("max_results").(int)
+	params := make(map[string]string)
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	tables := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		tables = append(tables, flattenDatasourceGoogleBigQueryTablesList(res["tables"], project)...)
+		if maxResults > 0 && len(tables) >= maxResults {
+			tables = tables[:maxResults]
+			return errStopPagination
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing BigQuery tables: %s", err)
+	}
+
+	if err := d.Set("tables", tables); err != nil {
+		return fmt.Errorf("Error setting tables: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/datasets/%s/tables", project, d.Get("dataset_id").(string)))
+
+	return nil
+}
+
+func flattenDatasourceGoogleBigQueryTablesList(v interface{}, project string) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	tables := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		table := raw.(map[string]interface{})
+
+		var tableId, datasetId, tableProject string
+		if ref, ok := table["tableReference"].(map[string]interface{}); ok {
+			tableId, _ = ref["tableId"].(string)
+			datasetId, _ = ref["datasetId"].(string)
+			tableProject, _ = ref["projectId"].(string)
+		}
+		if tableProject == "" {
+			tableProject = project
+		}
+
+		tables = append(tables, map[string]interface{}{
+			"table_id":        tableId,
+			"id":              fmt.Sprintf("projects/%s/datasets/%s/tables/%s", tableProject, datasetId, tableId),
+			"type":            table["type"],
+			"friendly_name":   table["friendlyName"],
+			"creation_time":   flattenDatasourceGoogleBigQueryTablesTime(table["creationTime"]),
+			"expiration_time": flattenDatasourceGoogleBigQueryTablesTime(table["expirationTime"]),
+			"labels":          table["labels"],
+		})
+	}
+	return tables
+}
+
+// Timestamps are returned as strings of milliseconds since the epoch.
+func flattenDatasourceGoogleBigQueryTablesTime(v interface{}) int64 {
+	if strVal, ok := v.(string); ok {
+		if intVal, err := stringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+	return 0
+}
@@ -0,0 +1,126 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleCloudRunV2Job() *schema.Resource {
+	dsSchema := datasourceSchemaFromResourceSchema(resourceCloudRunV2Job().Schema)
+	addRequiredFieldsToSchema(dsSchema, "name", "location")
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	// Task counts are only available on the execution itself, so they are
+	// read from the latest created execution in addition to the job.
+	dsSchema["latest_execution_status"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"task_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"succeeded_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"failed_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"running_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"cancelled_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"retried_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"completion_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleCloudRunV2JobRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleCloudRunV2JobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if err := resourceCloudRunV2JobRead(d, meta); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return fmt.Errorf("%s not found", id)
+	}
+
+	status := make([]interface{}, 0, 1)
+	if execution := d.Get("latest_created_execution.0.name").(string); execution != "" {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		url := fmt.Sprintf("%s%s", config.CloudRunV2BasePath, execution)
+		res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+		if err != nil {
+			return fmt.Errorf("Error reading execution %s: %s", execution, err)
+		}
+		status = append(status, flattenCloudRunV2JobExecutionStatus(res))
+	}
+
+	if err := d.Set("latest_execution_status", status); err != nil {
+		return fmt.Errorf("Error setting latest_execution_status: %s", err)
+	}
+
+	return nil
+}
+
+func flattenCloudRunV2JobExecutionStatus(res map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":            res["name"],
+		"task_count":      flattenCloudRunV2JobExecutionCount(res["taskCount"]),
+		"succeeded_count": flattenCloudRunV2JobExecutionCount(res["succeededCount"]),
+		"failed_count":    flattenCloudRunV2JobExecutionCount(res["failedCount"]),
+		"running_count":   flattenCloudRunV2JobExecutionCount(res["runningCount"]),
+		"cancelled_count": flattenCloudRunV2JobExecutionCount(res["cancelledCount"]),
+		"retried_count":   flattenCloudRunV2JobExecutionCount(res["retriedCount"]),
+		"completion_time": res["completionTime"],
+	}
+}
+
+// Counts are int32 in the API, which are decoded as JSON numbers and omitted
+// when zero.
+func flattenCloudRunV2JobExecutionCount(v interface{}) int {
+	if f, ok := v.(float64); ok {
+		return int(f)
+	}
+	return 0
+}
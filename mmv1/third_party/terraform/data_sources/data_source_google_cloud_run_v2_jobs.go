@@ -0,0 +1,146 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleCloudRunV2Jobs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleCloudRunV2JobsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"latest_created_execution": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"completion_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"completion_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleCloudRunV2JobsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs")
+	if err != nil {
+		return err
+	}
+
+	jobs := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, nil, func(res map[string]interface{}) error {
+		jobs = append(jobs, flattenDatasourceGoogleCloudRunV2JobsList(res["jobs"])...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Cloud Run jobs: %s", err)
+	}
+
+	if err := d.Set("jobs", jobs); err != nil {
+		return fmt.Errorf("Error setting jobs: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/jobs", project, d.Get("location").(string)))
+
+	return nil
+}
+
+func flattenDatasourceGoogleCloudRunV2JobsList(v interface{}) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	jobs := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		job := raw.(map[string]interface{})
+
+		execution := make([]interface{}, 0, 1)
+		if e, ok := job["latestCreatedExecution"].(map[string]interface{}); ok && len(e) > 0 {
+			execution = append(execution, map[string]interface{}{
+				"name":              e["name"],
+				"create_time":       e["createTime"],
+				"completion_time":   e["completionTime"],
+				"completion_status": e["completionStatus"],
+			})
+		}
+
+		jobs = append(jobs, map[string]interface{}{
+			"name":                     GetResourceNameFromSelfLink(job["name"].(string)),
+			"id":                       job["name"],
+			"uid":                      job["uid"],
+			"labels":                   job["labels"],
+			"execution_count":          flattenCloudRunV2JobExecutionCount(job["executionCount"]),
+			"latest_created_execution": execution,
+		})
+	}
+	return jobs
+}
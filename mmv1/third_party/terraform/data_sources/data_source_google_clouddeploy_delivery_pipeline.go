@@ -0,0 +1,191 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleClouddeployDeliveryPipeline() *schema.Resource {
+
+	dsSchema := datasourceSchemaFromResourceSchema(resourceClouddeployDeliveryPipeline().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "name", "location")
+
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	dsSchema["latest_release"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: `The most recently created release of the delivery pipeline.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"release_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"render_state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The render state of the release, one of SUCCEEDED, FAILED or IN_PROGRESS.`,
+				},
+				"abandoned": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"create_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+	dsSchema["latest_rollout"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: `The most recently created rollout of the latest release.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rollout_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"target_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `The state of the rollout, such as SUCCEEDED, FAILED, IN_PROGRESS or PENDING_APPROVAL.`,
+				},
+				"approval_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"create_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"deploy_end_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleClouddeployDeliveryPipelineRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleClouddeployDeliveryPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if err := resourceClouddeployDeliveryPipelineRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("%s not found", id)
+	}
+
+	release, err := getClouddeployLatestChild(config, project, clouddeployBasePath(config)+id+"/releases", "releases", userAgent)
+	if err != nil {
+		return fmt.Errorf("Error reading latest release of %s: %s", id, err)
+	}
+	if err := d.Set("latest_release", flattenDatasourceGoogleClouddeployRelease(release)); err != nil {
+		return fmt.Errorf("Error setting latest_release: %s", err)
+	}
+
+	var rollout map[string]interface{}
+	if release != nil {
+		rollout, err = getClouddeployLatestChild(config, project, clouddeployBasePath(config)+release["name"].(string)+"/rollouts", "rollouts", userAgent)
+		if err != nil {
+			return fmt.Errorf("Error reading latest rollout of %s: %s", id, err)
+		}
+	}
+	if err := d.Set("latest_rollout", flattenDatasourceGoogleClouddeployRollout(rollout)); err != nil {
+		return fmt.Errorf("Error setting latest_rollout: %s", err)
+	}
+
+	return nil
+}
+
+// getClouddeployLatestChild returns the most recently created release or
+// rollout in a collection, or nil if the collection is empty.
+func getClouddeployLatestChild(config *Config, project, url, key, userAgent string) (map[string]interface{}, error) {
+	url, err := addQueryParams(url, map[string]string{"orderBy": "create_time desc", "pageSize": "1"})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := res[key].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, nil
+	}
+	return items[0].(map[string]interface{}), nil
+}
+
+func flattenDatasourceGoogleClouddeployRelease(release map[string]interface{}) []interface{} {
+	if release == nil {
+		return nil
+	}
+	name, _ := release["name"].(string)
+	return []interface{}{
+		map[string]interface{}{
+			"name":         name,
+			"release_id":   GetResourceNameFromSelfLink(name),
+			"render_state": release["renderState"],
+			"abandoned":    release["abandoned"],
+			"create_time":  release["createTime"],
+		},
+	}
+}
+
+func flattenDatasourceGoogleClouddeployRollout(rollout map[string]interface{}) []interface{} {
+	if rollout == nil {
+		return nil
+	}
+	name, _ := rollout["name"].(string)
+	return []interface{}{
+		map[string]interface{}{
+			"name":            name,
+			"rollout_id":      GetResourceNameFromSelfLink(name),
+			"target_id":       rollout["targetId"],
+			"state":           rollout["state"],
+			"approval_state":  rollout["approvalState"],
+			"create_time":     rollout["createTime"],
+			"deploy_end_time": rollout["deployEndTime"],
+		},
+	}
+}
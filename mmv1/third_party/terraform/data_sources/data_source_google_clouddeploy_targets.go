@@ -0,0 +1,195 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleClouddeployTargets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleClouddeployTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The location of the targets.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the targets listed in the response,
+for example "labels.env = prod". The syntax is described in https://google.aip.dev/160.`,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"require_approval": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"gke_cluster": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The GKE cluster the target deploys to, if any.`,
+						},
+						"anthos_cluster_membership": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The GKE Hub membership the target deploys to, if any.`,
+						},
+						"run_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Cloud Run location the target deploys to, if any.`,
+						},
+						"multi_target_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The child targets of a multi-target, if any.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"annotations": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleClouddeployTargetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, clouddeployBasePath(config)+"projects/{{project}}/locations/{{location}}/targets")
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	targets := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		targets = append(targets, flattenDatasourceGoogleClouddeployTargetsList(res["targets"])...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Cloud Deploy targets: %s", err)
+	}
+
+	if err := d.Set("targets", targets); err != nil {
+		return fmt.Errorf("Error setting targets: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/targets", project, d.Get("location").(string)))
+
+	return nil
+}
+
+func flattenDatasourceGoogleClouddeployTargetsList(v interface{}) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	targets := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		target := raw.(map[string]interface{})
+
+		var gkeCluster, membership, runLocation interface{}
+		if gke, ok := target["gke"].(map[string]interface{}); ok {
+			gkeCluster = gke["cluster"]
+		}
+		if anthos, ok := target["anthosCluster"].(map[string]interface{}); ok {
+			membership = anthos["membership"]
+		}
+		if run, ok := target["run"].(map[string]interface{}); ok {
+			runLocation = run["location"]
+		}
+		var multiTargetIds interface{}
+		if multiTarget, ok := target["multiTarget"].(map[string]interface{}); ok {
+			multiTargetIds = multiTarget["targetIds"]
+		}
+
+		name, _ := target["name"].(string)
+		targets = append(targets, map[string]interface{}{
+			"name":                      name,
+			"target_id":                 GetResourceNameFromSelfLink(name),
+			"uid":                       target["uid"],
+			"description":               target["description"],
+			"require_approval":          target["requireApproval"],
+			"gke_cluster":               gkeCluster,
+			"anthos_cluster_membership": membership,
+			"run_location":              runLocation,
+			"multi_target_ids":          multiTargetIds,
+			"labels":                    target["labels"],
+			"annotations":               target["annotations"],
+			"create_time":               target["createTime"],
+			"update_time":               target["updateTime"],
+		})
+	}
+	return targets
+}
+
+// clouddeployBasePath returns the Cloud Deploy endpoint for handwritten calls.
+// The DCL leaves the configured base path empty unless a custom endpoint is set.
+func clouddeployBasePath(config *Config) string {
+	if config.ClouddeployBasePath != "" {
+		return config.ClouddeployBasePath
+	}
+	return "https://clouddeploy.googleapis.com/v1/"
+}
@@ -0,0 +1,165 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeAcceleratorTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeAcceleratorTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `If set, only the accelerator types of this zone are listed. Otherwise the accelerator types of all zones are listed.`,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the accelerator types listed in the response,
+for example "name = nvidia-l4". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"accelerator_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maximum_cards_per_instance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deprecated": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"replacement": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeAcceleratorTypesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone := d.Get("zone").(string)
+	id := fmt.Sprintf("projects/%s/aggregated/acceleratorTypes", project)
+	if zone != "" {
+		id = fmt.Sprintf("projects/%s/zones/%s/acceleratorTypes", project, zone)
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}"+id)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{}
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	acceleratorTypes := make([]map[string]interface{}, 0)
+	if zone != "" {
+		err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+			items, _ := res["items"].([]interface{})
+			for _, raw := range items {
+				acceleratorTypes = append(acceleratorTypes, flattenComputeAcceleratorTypesAcceleratorType(raw.(map[string]interface{})))
+			}
+			return nil
+		})
+	} else {
+		var items []aggregatedListItem
+		items, err = sendAggregatedListRequest(config, project, url, userAgent, "acceleratorTypes", params)
+		for _, item := range items {
+			acceleratorTypes = append(acceleratorTypes, flattenComputeAcceleratorTypesAcceleratorType(item.Item))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing accelerator types: %s", err)
+	}
+
+	sort.Slice(acceleratorTypes, func(i, j int) bool {
+		if acceleratorTypes[i]["zone"] != acceleratorTypes[j]["zone"] {
+			return acceleratorTypes[i]["zone"].(string) < acceleratorTypes[j]["zone"].(string)
+		}
+		return acceleratorTypes[i]["name"].(string) < acceleratorTypes[j]["name"].(string)
+	})
+
+	if err := d.Set("accelerator_types", acceleratorTypes); err != nil {
+		return fmt.Errorf("Error setting accelerator_types: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenComputeAcceleratorTypesAcceleratorType(acceleratorType map[string]interface{}) map[string]interface{} {
+	deprecated := make([]interface{}, 0)
+	if raw, ok := acceleratorType["deprecated"].(map[string]interface{}); ok {
+		deprecated = append(deprecated, map[string]interface{}{
+			"state":       raw["state"],
+			"replacement": raw["replacement"],
+		})
+	}
+
+	name, _ := acceleratorType["name"].(string)
+	zone, _ := acceleratorType["zone"].(string)
+
+	return map[string]interface{}{
+		"name":                       name,
+		"zone":                       GetResourceNameFromSelfLink(zone),
+		"description":                acceleratorType["description"],
+		"maximum_cards_per_instance": machineTypeInt(acceleratorType["maximumCardsPerInstance"]),
+		"deprecated":                 deprecated,
+		"self_link":                  acceleratorType["selfLink"],
+	}
+}
@@ -0,0 +1,231 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeFirewallRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `The name or self link of the network to list the firewall rules of.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that further filters the firewall rules listed in the response,
+for example "direction = INGRESS". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The direction of traffic the rule applies to, either INGRESS or EGRESS.`,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allow": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceGoogleComputeFirewallRulesRuleSchema(),
+						},
+						"deny": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceGoogleComputeFirewallRulesRuleSchema(),
+						},
+						"source_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"destination_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_service_accounts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_service_accounts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"log_config_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeFirewallRulesRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeFirewallRulesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	network, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/firewalls")
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	rules := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["items"].([]interface{}); ok {
+			for _, raw := range items {
+				rule := raw.(map[string]interface{})
+				// The firewalls list API can't filter on the full network URL, so only
+				// keep the rules of the requested network here.
+				if ruleNetwork, _ := rule["network"].(string); !strings.HasSuffix(ruleNetwork, "/"+network.RelativeLink()) {
+					continue
+				}
+				rules = append(rules, flattenDatasourceGoogleComputeFirewallRule(rule))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing firewall rules: %s", err)
+	}
+
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("Error setting rules: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/firewalls", network.RelativeLink()))
+
+	return nil
+}
+
+func flattenDatasourceGoogleComputeFirewallRule(rule map[string]interface{}) map[string]interface{} {
+	priority := 0
+	if v, ok := rule["priority"].(float64); ok {
+		priority = int(v)
+	}
+
+	logConfigEnabled := false
+	if logConfig, ok := rule["logConfig"].(map[string]interface{}); ok {
+		logConfigEnabled, _ = logConfig["enable"].(bool)
+	}
+
+	return map[string]interface{}{
+		"name":                    rule["name"],
+		"description":             rule["description"],
+		"direction":               rule["direction"],
+		"priority":                priority,
+		"disabled":                rule["disabled"],
+		"allow":                   flattenDatasourceGoogleComputeFirewallRuleProtocols(rule["allowed"]),
+		"deny":                    flattenDatasourceGoogleComputeFirewallRuleProtocols(rule["denied"]),
+		"source_ranges":           rule["sourceRanges"],
+		"destination_ranges":      rule["destinationRanges"],
+		"source_tags":             rule["sourceTags"],
+		"target_tags":             rule["targetTags"],
+		"source_service_accounts": rule["sourceServiceAccounts"],
+		"target_service_accounts": rule["targetServiceAccounts"],
+		"log_config_enabled":      logConfigEnabled,
+		"self_link":               rule["selfLink"],
+	}
+}
+
+func flattenDatasourceGoogleComputeFirewallRuleProtocols(v interface{}) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	protocols := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		protocol := raw.(map[string]interface{})
+		protocols = append(protocols, map[string]interface{}{
+			"protocol": protocol["IPProtocol"],
+			"ports":    protocol["ports"],
+		})
+	}
+	return protocols
+}
@@ -0,0 +1,174 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeForwardingRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeForwardingRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the forwarding rules listed in the response,
+for example "loadBalancingScheme = EXTERNAL". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ports": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"all_ports": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"load_balancing_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URL of the target resource, such as a target pool, that receives the matched traffic.`,
+						},
+						"backend_service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnetwork": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeForwardingRulesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, fmt.Sprintf("{{ComputeBasePath}}projects/{{project}}/regions/%s/forwardingRules", region))
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	rules := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["items"].([]interface{}); ok {
+			for _, raw := range items {
+				rule := raw.(map[string]interface{})
+				rules = append(rules, map[string]interface{}{
+					"name":                  rule["name"],
+					"description":           rule["description"],
+					"ip_address":            rule["IPAddress"],
+					"ip_protocol":           rule["IPProtocol"],
+					"port_range":            rule["portRange"],
+					"ports":                 rule["ports"],
+					"all_ports":             rule["allPorts"],
+					"load_balancing_scheme": rule["loadBalancingScheme"],
+					"network_tier":          rule["networkTier"],
+					"target":                rule["target"],
+					"backend_service":       rule["backendService"],
+					"network":               rule["network"],
+					"subnetwork":            rule["subnetwork"],
+					"labels":                rule["labels"],
+					"self_link":             rule["selfLink"],
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing forwarding rules: %s", err)
+	}
+
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("Error setting rules: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return fmt.Errorf("Error setting region: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/regions/%s/forwardingRules", project, region))
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeInstanceGroupManagerInstancesPreservedStateSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"device_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"source": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"mode": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"auto_delete": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"metadata": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstanceGroupManagerInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInstanceGroupManagerInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_group_manager": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The name or self link of the zonal instance group manager.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the managed instances listed in the response,
+for example "instanceStatus = RUNNING". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"managed_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URL of the instance.`,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The action the instance group manager is currently performing on the instance, or NONE.`,
+						},
+						"version": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_template": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"instance_health": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"health_check": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"detailed_health_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"preserved_state_from_config": dataSourceGoogleComputeInstanceGroupManagerInstancesPreservedStateSchema(`Preserved state applied from the per-instance config.`),
+						"preserved_state_from_policy": dataSourceGoogleComputeInstanceGroupManagerInstancesPreservedStateSchema(`Preserved state generated based on the stateful policy.`),
+						"last_attempt_errors": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The error messages encountered during the last attempt to create or delete the instance.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstanceGroupManagerInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	igm, err := parseZonalFieldValue("instanceGroupManagers", d.Get("instance_group_manager").(string), "project", "zone", d, config, false)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, fmt.Sprintf("{{ComputeBasePath}}projects/%s/zones/%s/instanceGroupManagers/%s/listManagedInstances", igm.Project, igm.Zone, igm.Name))
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	managedInstances := make([]map[string]interface{}, 0)
+	err = sendPaginatedRequest(config, "POST", igm.Project, url, userAgent, params, DefaultRequestTimeout, func(res map[string]interface{}) error {
+		if items, ok := res["managedInstances"].([]interface{}); ok {
+			for _, raw := range items {
+				managedInstances = append(managedInstances, flattenComputeInstanceGroupManagerManagedInstance(raw.(map[string]interface{})))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing managed instances of %s: %s", igm.Name, err)
+	}
+
+	if err := d.Set("managed_instances", managedInstances); err != nil {
+		return fmt.Errorf("Error setting managed_instances: %s", err)
+	}
+	if err := d.Set("project", igm.Project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("zone", igm.Zone); err != nil {
+		return fmt.Errorf("Error setting zone: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/zones/%s/instanceGroupManagers/%s/managedInstances", igm.Project, igm.Zone, igm.Name))
+
+	return nil
+}
+
+func flattenComputeInstanceGroupManagerManagedInstance(mi map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":                        mi["name"],
+		"instance":                    mi["instance"],
+		"id":                          mi["id"],
+		"instance_status":             mi["instanceStatus"],
+		"current_action":              mi["currentAction"],
+		"preserved_state_from_config": flattenComputeInstanceGroupManagerPreservedState(mi["preservedStateFromConfig"]),
+		"preserved_state_from_policy": flattenComputeInstanceGroupManagerPreservedState(mi["preservedStateFromPolicy"]),
+	}
+
+	if v, ok := mi["version"].(map[string]interface{}); ok {
+		result["version"] = []interface{}{
+			map[string]interface{}{
+				"instance_template": v["instanceTemplate"],
+				"name":              v["name"],
+			},
+		}
+	}
+
+	health := make([]interface{}, 0)
+	if raw, ok := mi["instanceHealth"].([]interface{}); ok {
+		for _, h := range raw {
+			hm := h.(map[string]interface{})
+			health = append(health, map[string]interface{}{
+				"health_check":          hm["healthCheck"],
+				"detailed_health_state": hm["detailedHealthState"],
+			})
+		}
+	}
+	result["instance_health"] = health
+
+	errs := make([]interface{}, 0)
+	if la, ok := mi["lastAttempt"].(map[string]interface{}); ok {
+		if e, ok := la["errors"].(map[string]interface{}); ok {
+			if raw, ok := e["errors"].([]interface{}); ok {
+				for _, item := range raw {
+					errs = append(errs, item.(map[string]interface{})["message"])
+				}
+			}
+		}
+	}
+	result["last_attempt_errors"] = errs
+
+	return result
+}
+
+func flattenComputeInstanceGroupManagerPreservedState(v interface{}) []interface{} {
+	ps, ok := v.(map[string]interface{})
+	if !ok || len(ps) == 0 {
+		return nil
+	}
+
+	disks := make([]interface{}, 0)
+	if raw, ok := ps["disks"].(map[string]interface{}); ok {
+		deviceNames := make([]string, 0, len(raw))
+		for deviceName := range raw {
+			deviceNames = append(deviceNames, deviceName)
+		}
+		// Sort by device name so the list is stable between reads.
+		sort.Strings(deviceNames)
+		for _, deviceName := range deviceNames {
+			disk := raw[deviceName].(map[string]interface{})
+			disks = append(disks, map[string]interface{}{
+				"device_name": deviceName,
+				"source":      disk["source"],
+				"mode":        disk["mode"],
+				"auto_delete": disk["autoDelete"],
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"disk":     disks,
+			"metadata": ps["metadata"],
+		},
+	}
+}
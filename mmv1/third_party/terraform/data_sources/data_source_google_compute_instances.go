@@ -0,0 +1,186 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the instances listed in the response,
+for example "labels.env = prod". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"machine_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subnetwork": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"network_ip": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"nat_ip": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The external IP address of the first access config of the interface, if any.`,
+									},
+								},
+							},
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/aggregated/instances")
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"returnPartialSuccess": "true",
+	}
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	instances := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["items"].(map[string]interface{}); ok {
+			for _, scoped := range items {
+				list, ok := scoped.(map[string]interface{})["instances"].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, raw := range list {
+					instances = append(instances, flattenComputeInstancesInstance(raw.(map[string]interface{})))
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing instances: %s", err)
+	}
+
+	// The aggregated list is keyed by zone, so sort to keep the order stable between reads.
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i]["zone"] != instances[j]["zone"] {
+			return instances[i]["zone"].(string) < instances[j]["zone"].(string)
+		}
+		return instances[i]["name"].(string) < instances[j]["name"].(string)
+	})
+
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/aggregated/instances", project))
+
+	return nil
+}
+
+func flattenComputeInstancesInstance(instance map[string]interface{}) map[string]interface{} {
+	name, _ := instance["name"].(string)
+	zone, _ := instance["zone"].(string)
+	machineType, _ := instance["machineType"].(string)
+
+	nics := make([]interface{}, 0)
+	if raw, ok := instance["networkInterfaces"].([]interface{}); ok {
+		for _, n := range raw {
+			nic := n.(map[string]interface{})
+			natIP := ""
+			if acs, ok := nic["accessConfigs"].([]interface{}); ok && len(acs) > 0 {
+				natIP, _ = acs[0].(map[string]interface{})["natIP"].(string)
+			}
+			nics = append(nics, map[string]interface{}{
+				"name":       nic["name"],
+				"network":    nic["network"],
+				"subnetwork": nic["subnetwork"],
+				"network_ip": nic["networkIP"],
+				"nat_ip":     natIP,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"name":              name,
+		"zone":              GetResourceNameFromSelfLink(zone),
+		"machine_type":      GetResourceNameFromSelfLink(machineType),
+		"status":            instance["status"],
+		"labels":            instance["labels"],
+		"network_interface": nics,
+		"self_link":         instance["selfLink"],
+	}
+}
@@ -0,0 +1,211 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeInterconnectLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInterconnectLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the interconnect locations listed in the response,
+for example "continent = NORTH_AMERICA". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The postal address of the Point of Presence.`,
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The availability zone for this location, such as "zone1" or "zone2".`,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facility_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facility_provider_facility_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peeringdb_facility_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supports_pzs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"region_infos": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"expected_rtt_ms": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"location_presence": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInterconnectLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnectLocations")
+	if err != nil {
+		return err
+	}
+
+	items, err := listComputeInterconnectLocationItems(d, config, project, url, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error listing interconnect locations: %s", err)
+	}
+
+	locations := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		location := raw.(map[string]interface{})
+		locations = append(locations, map[string]interface{}{
+			"name":                          location["name"],
+			"description":                   location["description"],
+			"address":                       location["address"],
+			"availability_zone":             location["availabilityZone"],
+			"city":                          location["city"],
+			"continent":                     location["continent"],
+			"facility_provider":             location["facilityProvider"],
+			"facility_provider_facility_id": location["facilityProviderFacilityId"],
+			"peeringdb_facility_id":         location["peeringdbFacilityId"],
+			"status":                        location["status"],
+			"supports_pzs":                  location["supportsPzs"],
+			"region_infos":                  flattenComputeInterconnectLocationRegionInfos(location["regionInfos"]),
+			"self_link":                     location["selfLink"],
+		})
+	}
+
+	if err := d.Set("locations", locations); err != nil {
+		return fmt.Errorf("Error setting locations: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/global/interconnectLocations", project))
+
+	return nil
+}
+
+// listComputeInterconnectLocationItems pages through a compute list endpoint for
+// interconnect locations or remote locations and returns all listed items.
+func listComputeInterconnectLocationItems(d *schema.ResourceData, config *Config, project, url, userAgent string) ([]interface{}, error) {
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	items := make([]interface{}, 0)
+	err := listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if ls, ok := res["items"].([]interface{}); ok {
+			items = append(items, ls...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func flattenComputeInterconnectLocationRegionInfos(v interface{}) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	infos := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		info := raw.(map[string]interface{})
+		region, _ := info["region"].(string)
+		infos = append(infos, map[string]interface{}{
+			"region":            GetResourceNameFromSelfLink(region),
+			"expected_rtt_ms":   flattenComputeInterconnectLocationInt(info["expectedRttMs"]),
+			"location_presence": info["locationPresence"],
+		})
+	}
+	return infos
+}
+
+// int64 values are returned as strings by the compute API.
+func flattenComputeInterconnectLocationInt(v interface{}) interface{} {
+	if strVal, ok := v.(string); ok {
+		if intVal, err := stringToFixed64(strVal); err == nil {
+			return intVal
+		}
+	}
+	if floatVal, ok := v.(float64); ok {
+		return int(floatVal)
+	}
+	return v
+}
@@ -0,0 +1,178 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeInterconnectRemoteLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInterconnectRemoteLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the interconnect remote locations listed in the response,
+for example "remoteService = AWS". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"remote_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The postal address of the Point of Presence.`,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facility_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facility_provider_facility_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peeringdb_facility_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The cloud service provider reachable from this location, such as "AWS" or "Azure".`,
+						},
+						"lacp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_lag_size_10_gbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_lag_size_100_gbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"permitted_connections": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The interconnect locations that can be used to connect to this remote location.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInterconnectRemoteLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnectRemoteLocations")
+	if err != nil {
+		return err
+	}
+
+	items, err := listComputeInterconnectLocationItems(d, config, project, url, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error listing interconnect remote locations: %s", err)
+	}
+
+	remoteLocations := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		location := raw.(map[string]interface{})
+		remoteLocations = append(remoteLocations, map[string]interface{}{
+			"name":                          location["name"],
+			"description":                   location["description"],
+			"address":                       location["address"],
+			"city":                          location["city"],
+			"continent":                     location["continent"],
+			"facility_provider":             location["facilityProvider"],
+			"facility_provider_facility_id": location["facilityProviderFacilityId"],
+			"peeringdb_facility_id":         location["peeringdbFacilityId"],
+			"remote_service":                location["remoteService"],
+			"lacp":                          location["lacp"],
+			"link_type":                     location["linkType"],
+			"max_lag_size_10_gbps":          flattenComputeInterconnectLocationInt(location["maxLagSize10Gbps"]),
+			"max_lag_size_100_gbps":         flattenComputeInterconnectLocationInt(location["maxLagSize100Gbps"]),
+			"permitted_connections":         flattenComputeInterconnectRemoteLocationPermittedConnections(location["permittedConnections"]),
+			"status":                        location["status"],
+			"self_link":                     location["selfLink"],
+		})
+	}
+
+	if err := d.Set("remote_locations", remoteLocations); err != nil {
+		return fmt.Errorf("Error setting remote_locations: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/global/interconnectRemoteLocations", project))
+
+	return nil
+}
+
+func flattenComputeInterconnectRemoteLocationPermittedConnections(v interface{}) []string {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	locations := make([]string, 0, len(ls))
+	for _, raw := range ls {
+		connection, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if location, ok := connection["interconnectLocation"].(string); ok {
+			locations = append(locations, GetResourceNameFromSelfLink(location))
+		}
+	}
+	return locations
+}
@@ -0,0 +1,345 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGoogleComputeMachineTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeMachineTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the machine types listed in the response,
+for example "isSharedCpu = false". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"min_guest_cpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  `If set, only machine types with at least this many virtual CPUs are returned.`,
+			},
+			"min_memory_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  `If set, only machine types with at least this much memory, in MB, are returned.`,
+			},
+			"accelerator_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `If set, only machine types that bundle this accelerator type, for example "nvidia-tesla-a100", are returned.`,
+			},
+			"machine_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guest_cpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_shared_cpu": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"maximum_persistent_disks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"maximum_persistent_disks_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"accelerators": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"guest_accelerator_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"guest_accelerator_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"bundled_local_ssds": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_interface": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"partition_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"supported_interfaces": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"deprecated": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"replacement": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"supported_disk_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_disk_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"valid_disk_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeMachineTypesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/aggregated/machineTypes")
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{}
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+	minGuestCpus := d.Get("min_guest_cpus").(int)
+	minMemoryMb := d.Get("min_memory_mb").(int)
+	acceleratorType := d.Get("accelerator_type").(string)
+
+	items, err := sendAggregatedListRequest(config, project, url, userAgent, "machineTypes", params)
+	if err != nil {
+		return fmt.Errorf("Error listing machine types in zone %s: %s", zone, err)
+	}
+
+	machineTypes := make([]map[string]interface{}, 0)
+	for _, item := range items {
+		if item.ScopeName() != zone {
+			continue
+		}
+		machineType := flattenComputeMachineTypesMachineType(item.Item)
+		if machineType["guest_cpus"].(int) < minGuestCpus || machineType["memory_mb"].(int) < minMemoryMb {
+			continue
+		}
+		if acceleratorType != "" && !machineTypeHasAccelerator(machineType, acceleratorType) {
+			continue
+		}
+		machineTypes = append(machineTypes, machineType)
+	}
+
+	sort.Slice(machineTypes, func(i, j int) bool {
+		return machineTypes[i]["name"].(string) < machineTypes[j]["name"].(string)
+	})
+
+	if err := d.Set("machine_types", machineTypes); err != nil {
+		return fmt.Errorf("Error setting machine_types: %s", err)
+	}
+
+	diskTypesUrl, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/aggregated/diskTypes")
+	if err != nil {
+		return err
+	}
+
+	diskTypeItems, err := sendAggregatedListRequest(config, project, diskTypesUrl, userAgent, "diskTypes", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing disk types in zone %s: %s", zone, err)
+	}
+
+	diskTypes := make([]map[string]interface{}, 0)
+	for _, item := range diskTypeItems {
+		if item.ScopeName() == zone {
+			diskTypes = append(diskTypes, flattenComputeMachineTypesDiskType(item.Item))
+		}
+	}
+	sort.Slice(diskTypes, func(i, j int) bool {
+		return diskTypes[i]["name"].(string) < diskTypes[j]["name"].(string)
+	})
+
+	if err := d.Set("supported_disk_types", diskTypes); err != nil {
+		return fmt.Errorf("Error setting supported_disk_types: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("zone", zone); err != nil {
+		return fmt.Errorf("Error setting zone: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/zones/%s/machineTypes", project, zone))
+
+	return nil
+}
+
+func flattenComputeMachineTypesMachineType(machineType map[string]interface{}) map[string]interface{} {
+	accelerators := make([]interface{}, 0)
+	if raw, ok := machineType["accelerators"].([]interface{}); ok {
+		for _, a := range raw {
+			accelerator := a.(map[string]interface{})
+			accelerators = append(accelerators, map[string]interface{}{
+				"guest_accelerator_type":  accelerator["guestAcceleratorType"],
+				"guest_accelerator_count": machineTypeInt(accelerator["guestAcceleratorCount"]),
+			})
+		}
+	}
+
+	bundledLocalSsds := make([]interface{}, 0)
+	if raw, ok := machineType["bundledLocalSsds"].(map[string]interface{}); ok {
+		bundledLocalSsds = append(bundledLocalSsds, map[string]interface{}{
+			"default_interface":    raw["defaultInterface"],
+			"partition_count":      machineTypeInt(raw["partitionCount"]),
+			"supported_interfaces": raw["supportedInterfaces"],
+		})
+	}
+
+	deprecated := make([]interface{}, 0)
+	if raw, ok := machineType["deprecated"].(map[string]interface{}); ok {
+		deprecated = append(deprecated, map[string]interface{}{
+			"state":       raw["state"],
+			"replacement": raw["replacement"],
+		})
+	}
+
+	name, _ := machineType["name"].(string)
+	isSharedCpu, _ := machineType["isSharedCpu"].(bool)
+
+	return map[string]interface{}{
+		"name":                             name,
+		"description":                      machineType["description"],
+		"guest_cpus":                       machineTypeInt(machineType["guestCpus"]),
+		"memory_mb":                        machineTypeInt(machineType["memoryMb"]),
+		"is_shared_cpu":                    isSharedCpu,
+		"maximum_persistent_disks":         machineTypeInt(machineType["maximumPersistentDisks"]),
+		"maximum_persistent_disks_size_gb": machineTypeInt(machineType["maximumPersistentDisksSizeGb"]),
+		"accelerators":                     accelerators,
+		"bundled_local_ssds":               bundledLocalSsds,
+		"deprecated":                       deprecated,
+		"self_link":                        machineType["selfLink"],
+	}
+}
+
+func flattenComputeMachineTypesDiskType(diskType map[string]interface{}) map[string]interface{} {
+	name, _ := diskType["name"].(string)
+
+	return map[string]interface{}{
+		"name":                 name,
+		"description":          diskType["description"],
+		"default_disk_size_gb": machineTypeInt(diskType["defaultDiskSizeGb"]),
+		"valid_disk_size":      diskType["validDiskSize"],
+		"self_link":            diskType["selfLink"],
+	}
+}
+
+func machineTypeHasAccelerator(machineType map[string]interface{}, acceleratorType string) bool {
+	for _, a := range machineType["accelerators"].([]interface{}) {
+		if a.(map[string]interface{})["guest_accelerator_type"] == acceleratorType {
+			return true
+		}
+	}
+	return false
+}
+
+// The compute API returns int32 fields as JSON numbers and int64 fields as
+// strings, so accept both.
+func machineTypeInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		i, _ := stringToFixed64(n)
+		return int(i)
+	}
+	return 0
+}
@@ -0,0 +1,43 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeNetworkAttachment() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceComputeNetworkAttachment().Schema)
+
+	// Set 'Required' schema elements
+	addRequiredFieldsToSchema(dsSchema, "name")
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project")
+	addOptionalFieldsToSchema(dsSchema, "region")
+
+	return &schema.Resource{
+		Read:   dataSourceComputeNetworkAttachmentRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceComputeNetworkAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, region, name, err := GetRegionalResourcePropertiesFromSelfLinkOrSchema(d, config)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/regions/%s/networkAttachments/%s", project, region, name))
+
+	if err := resourceComputeNetworkAttachmentRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Network attachment %s not found in region %s of project %s", name, region, project)
+	}
+	return nil
+}
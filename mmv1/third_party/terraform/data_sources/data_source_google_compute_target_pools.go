@@ -0,0 +1,145 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleComputeTargetPools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeTargetPoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the target pools listed in the response,
+for example "sessionAffinity = CLIENT_IP". The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"target_pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instances": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The URLs of the instances in the target pool.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"health_checks": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The URLs of the legacy HTTP health checks of the target pool.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"session_affinity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_pool": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failover_ratio": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"security_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeTargetPoolsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, fmt.Sprintf("{{ComputeBasePath}}projects/{{project}}/regions/%s/targetPools", region))
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	targetPools := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["items"].([]interface{}); ok {
+			for _, raw := range items {
+				pool := raw.(map[string]interface{})
+				targetPools = append(targetPools, map[string]interface{}{
+					"name":             pool["name"],
+					"description":      pool["description"],
+					"instances":        pool["instances"],
+					"health_checks":    pool["healthChecks"],
+					"session_affinity": pool["sessionAffinity"],
+					"backup_pool":      pool["backupPool"],
+					"failover_ratio":   pool["failoverRatio"],
+					"security_policy":  pool["securityPolicy"],
+					"self_link":        pool["selfLink"],
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing target pools: %s", err)
+	}
+
+	if err := d.Set("target_pools", targetPools); err != nil {
+		return fmt.Errorf("Error setting target_pools: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return fmt.Errorf("Error setting region: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/regions/%s/targetPools", project, region))
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGoogleComputeUsableSubnetworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeUsableSubnetworksRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `If set, only the usable subnetworks in this region are returned.`,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A filter expression that filters the usable subnetworks listed in the response.
+The syntax is the same as for the filter of the compute list APIs.`,
+			},
+			"service_project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The project ID or project number of the service project in which the subnetworks are
+intended to be used. Only applies to subnetworks shared through Shared VPC.`,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+				Description:  `The maximum number of results per page requested from the API.`,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of subnetworks to return. Pagination stops once this many subnetworks have been found.`,
+			},
+			"subnetworks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnetwork": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URL of the subnetwork.`,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URL of the network the subnetwork belongs to.`,
+						},
+						"ip_cidr_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secondary_ip_ranges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"range_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_cidr_range": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"stack_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal_ipv6_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ipv6_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purpose": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeUsableSubnetworksRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/aggregated/subnetworks/listUsable")
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+	if serviceProject, ok := d.GetOk("service_project"); ok {
+		params["serviceProject"] = serviceProject.(string)
+	}
+	if maxResults, ok := d.GetOk("max_results"); ok {
+		params["maxResults"] = strconv.Itoa(maxResults.(int))
+	}
+	region := d.Get("region").(string)
+	limit := d.Get("limit").(int)
+
+	subnetworks := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["items"].([]interface{}); ok {
+			for _, raw := range items {
+				subnetwork := flattenComputeUsableSubnetwork(raw.(map[string]interface{}))
+				if region != "" && subnetwork["region"] != region {
+					continue
+				}
+				subnetworks = append(subnetworks, subnetwork)
+				if limit > 0 && len(subnetworks) >= limit {
+					break
+				}
+			}
+		}
+
+		if limit > 0 && len(subnetworks) >= limit {
+			return errStopPagination
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing usable subnetworks: %s", err)
+	}
+
+	if err := d.Set("subnetworks", subnetworks); err != nil {
+		return fmt.Errorf("Error setting subnetworks: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+
+	id := fmt.Sprintf("projects/%s/aggregated/subnetworks/listUsable", project)
+	if region != "" {
+		id = fmt.Sprintf("projects/%s/regions/%s/subnetworks/listUsable", project, region)
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenComputeUsableSubnetwork(s map[string]interface{}) map[string]interface{} {
+	selfLink, _ := s["subnetwork"].(string)
+
+	secondaryRanges := make([]interface{}, 0)
+	if raw, ok := s["secondaryIpRanges"].([]interface{}); ok {
+		for _, r := range raw {
+			rm := r.(map[string]interface{})
+			secondaryRanges = append(secondaryRanges, map[string]interface{}{
+				"range_name":    rm["rangeName"],
+				"ip_cidr_range": rm["ipCidrRange"],
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"subnetwork":           selfLink,
+		"name":                 GetResourceNameFromSelfLink(selfLink),
+		"region":               GetRegionFromRegionalSelfLink(selfLink),
+		"network":              s["network"],
+		"ip_cidr_range":        s["ipCidrRange"],
+		"secondary_ip_ranges":  secondaryRanges,
+		"stack_type":           s["stackType"],
+		"ipv6_access_type":     s["ipv6AccessType"],
+		"internal_ipv6_prefix": s["internalIpv6Prefix"],
+		"external_ipv6_prefix": s["externalIpv6Prefix"],
+		"purpose":              s["purpose"],
+		"role":                 s["role"],
+	}
+}
@@ -0,0 +1,114 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleEssentialContacts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleEssentialContactsRead,
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: `The resource to list the contacts of. Format: organizations/{organization_id},
+folders/{folder_id} or projects/{project_id}`,
+			},
+			"notification_categories": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: `Only return contacts subscribed to one of these notification categories, such as
+SECURITY or TECHNICAL. Contacts inherited from the ancestors of the parent are included.`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validateEnum([]string{"ALL", "SUSPENSION", "SECURITY", "TECHNICAL", "BILLING",
+						"LEGAL", "PRODUCT_UPDATES", "TECHNICAL_INCIDENTS"}),
+				},
+			},
+			"contacts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The identifier for the contact. Format: {resourceType}/{resource_id}/contacts/{contact_id}`,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notification_category_subscriptions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"language_tag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"validation_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Whether the contact's email address has been validated, either VALID or INVALID.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleEssentialContactsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	url, err := replaceVars(d, config, "{{EssentialContactsBasePath}}{{parent}}/contacts")
+	if err != nil {
+		return err
+	}
+
+	// Listing the contacts of a category uses the compute method, which also
+	// returns the contacts inherited from the ancestors of the parent.
+	categories := convertStringArr(d.Get("notification_categories").([]interface{}))
+	if len(categories) > 0 {
+		url += ":compute"
+		params["notificationCategories"] = strings.Join(categories, ",")
+	}
+
+	contacts := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, "", url, userAgent, params, func(res map[string]interface{}) error {
+		if ls, ok := res["contacts"].([]interface{}); ok {
+			for _, raw := range ls {
+				contact := raw.(map[string]interface{})
+				contacts = append(contacts, map[string]interface{}{
+					"name":                                contact["name"],
+					"email":                               contact["email"],
+					"notification_category_subscriptions": contact["notificationCategorySubscriptions"],
+					"language_tag":                        contact["languageTag"],
+					"validation_state":                    contact["validationState"],
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Essential Contacts: %s", err)
+	}
+
+	if err := d.Set("contacts", contacts); err != nil {
+		return fmt.Errorf("Error setting contacts: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/contacts", d.Get("parent").(string)))
+
+	return nil
+}
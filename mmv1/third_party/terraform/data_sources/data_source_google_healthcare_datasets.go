@@ -0,0 +1,87 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleHealthcareDatasets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleHealthcareDatasetsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datasets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleHealthcareDatasetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{HealthcareBasePath}}projects/{{project}}/locations/{{location}}/datasets")
+	if err != nil {
+		return err
+	}
+
+	res, err := listHealthcareResources(config, project, url, userAgent, "datasets")
+	if err != nil {
+		return fmt.Errorf("Error listing Healthcare datasets: %s", err)
+	}
+
+	datasets := make([]map[string]interface{}, 0, len(res))
+	for _, raw := range res {
+		dataset := raw.(map[string]interface{})
+		datasets = append(datasets, map[string]interface{}{
+			"name":      GetResourceNameFromSelfLink(dataset["name"].(string)),
+			"id":        dataset["name"],
+			"time_zone": dataset["timeZone"],
+		})
+	}
+
+	if err := d.Set("datasets", datasets); err != nil {
+		return fmt.Errorf("Error setting datasets: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/datasets", project, d.Get("location").(string)))
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleHealthcareDicomStores() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleHealthcareDicomStoresRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dicom_stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"notification_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pubsub_topic": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleHealthcareDicomStoresRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	datasetId, err := parseHealthcareDatasetId(d.Get("dataset").(string), config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s%s/dicomStores", config.HealthcareBasePath, datasetId.datasetId())
+	res, err := listHealthcareResources(config, datasetId.Project, url, userAgent, "dicomStores")
+	if err != nil {
+		return fmt.Errorf("Error listing Healthcare DICOM stores: %s", err)
+	}
+
+	stores := make([]map[string]interface{}, 0, len(res))
+	for _, raw := range res {
+		store := raw.(map[string]interface{})
+		stores = append(stores, map[string]interface{}{
+			"name":                GetResourceNameFromSelfLink(store["name"].(string)),
+			"id":                  store["name"],
+			"labels":              store["labels"],
+			"notification_config": flattenHealthcareStoreNotificationConfig(store["notificationConfig"]),
+		})
+	}
+
+	if err := d.Set("dicom_stores", stores); err != nil {
+		return fmt.Errorf("Error setting dicom_stores: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/dicomStores", datasetId.datasetId()))
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleHealthcareFhirStores() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleHealthcareFhirStoresRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fhir_stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_update_create": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disable_referential_integrity": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"disable_resource_versioning": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_history_import": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"notification_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pubsub_topic": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleHealthcareFhirStoresRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	datasetId, err := parseHealthcareDatasetId(d.Get("dataset").(string), config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s%s/fhirStores", config.HealthcareBasePath, datasetId.datasetId())
+	res, err := listHealthcareResources(config, datasetId.Project, url, userAgent, "fhirStores")
+	if err != nil {
+		return fmt.Errorf("Error listing Healthcare FHIR stores: %s", err)
+	}
+
+	stores := make([]map[string]interface{}, 0, len(res))
+	for _, raw := range res {
+		store := raw.(map[string]interface{})
+		stores = append(stores, map[string]interface{}{
+			"name":                          GetResourceNameFromSelfLink(store["name"].(string)),
+			"id":                            store["name"],
+			"version":                       store["version"],
+			"enable_update_create":          store["enableUpdateCreate"],
+			"disable_referential_integrity": store["disableReferentialIntegrity"],
+			"disable_resource_versioning":   store["disableResourceVersioning"],
+			"enable_history_import":         store["enableHistoryImport"],
+			"labels":                        store["labels"],
+			"notification_config":           flattenHealthcareStoreNotificationConfig(store["notificationConfig"]),
+		})
+	}
+
+	if err := d.Set("fhir_stores", stores); err != nil {
+		return fmt.Errorf("Error setting fhir_stores: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/fhirStores", datasetId.datasetId()))
+
+	return nil
+}
+
+// flattenHealthcareStoreNotificationConfig flattens the single
+// `notificationConfig` message shared by FHIR and DICOM stores.
+func flattenHealthcareStoreNotificationConfig(v interface{}) []interface{} {
+	config, ok := v.(map[string]interface{})
+	if !ok || len(config) == 0 {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"pubsub_topic": config["pubsubTopic"],
+		},
+	}
+}
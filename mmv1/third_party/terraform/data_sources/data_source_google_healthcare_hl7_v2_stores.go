@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleHealthcareHl7V2Stores() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleHealthcareHl7V2StoresRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hl7_v2_stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parser_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"notification_configs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pubsub_topic": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"filter": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleHealthcareHl7V2StoresRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	datasetId, err := parseHealthcareDatasetId(d.Get("dataset").(string), config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s%s/hl7V2Stores", config.HealthcareBasePath, datasetId.datasetId())
+	res, err := listHealthcareResources(config, datasetId.Project, url, userAgent, "hl7V2Stores")
+	if err != nil {
+		return fmt.Errorf("Error listing Healthcare HL7v2 stores: %s", err)
+	}
+
+	stores := make([]map[string]interface{}, 0, len(res))
+	for _, raw := range res {
+		store := raw.(map[string]interface{})
+		var parserVersion interface{}
+		if parserConfig, ok := store["parserConfig"].(map[string]interface{}); ok {
+			parserVersion = parserConfig["version"]
+		}
+		stores = append(stores, map[string]interface{}{
+			"name":                 GetResourceNameFromSelfLink(store["name"].(string)),
+			"id":                   store["name"],
+			"parser_version":       parserVersion,
+			"labels":               store["labels"],
+			"notification_configs": flattenHealthcareHl7V2StoreNotificationConfigs(store["notificationConfigs"]),
+		})
+	}
+
+	if err := d.Set("hl7_v2_stores", stores); err != nil {
+		return fmt.Errorf("Error setting hl7_v2_stores: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/hl7V2Stores", datasetId.datasetId()))
+
+	return nil
+}
+
+func flattenHealthcareHl7V2StoreNotificationConfigs(v interface{}) []interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	configs := make([]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		config := raw.(map[string]interface{})
+		configs = append(configs, map[string]interface{}{
+			"pubsub_topic": config["pubsubTopic"],
+			"filter":       config["filter"],
+		})
+	}
+	return configs
+}
@@ -0,0 +1,75 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleImportableResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleImportableResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(resourceImportListerTypes()),
+				Description:  `The type of resource to list, such as "google_storage_bucket".`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The import IDs of the existing resources, keyed by a name that is unique within the project.`,
+			},
+			"import_blocks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Terraform import blocks for every existing resource, for use with terraform plan -generate-config-out.`,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleImportableResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	lister, ok := resourceImportListers[resourceType]
+	if !ok {
+		return fmt.Errorf("Listing existing resources is not supported for %s", resourceType)
+	}
+
+	ids, err := lister(config, project, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error listing %s resources: %s", resourceType, err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting ids: %s", err)
+	}
+	if err := d.Set("import_blocks", renderImportBlocks(resourceType, ids)); err != nil {
+		return fmt.Errorf("Error setting import_blocks: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/importableResources/%s", project, resourceType))
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceGoogleOSConfigInventories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleOSConfigInventoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project to list the inventories of. If it is not provided, the provider project is used.`,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The zone to list the inventories of. If it is not provided, the provider zone is used.`,
+			},
+			"instance": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-",
+				Description: `The name or ID of the VM instance to list the inventory of. By default, the inventories of all the VM instances of the zone are listed.`,
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A filter expression passed to the List method of the API, which restricts the inventories returned.`,
+			},
+			"view": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "FULL",
+				ValidateFunc: validation.StringInSlice([]string{"BASIC", "FULL"}, false),
+				Description:  `The view of the inventories, BASIC or FULL. The package summaries are only available with the FULL view.`,
+			},
+			"inventories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the inventory, of the form projects/{project_number}/locations/{zone}/instances/{instance_id}/inventory.`,
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the VM instance.`,
+						},
+						"update_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time the inventory was last updated.`,
+						},
+						"os_info": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Information about the operating system of the VM instance.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"long_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"short_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"architecture": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kernel_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kernel_release": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"osconfig_agent_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"installed_package_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of packages installed on the VM instance.`,
+						},
+						"available_package_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of package updates available for the VM instance.`,
+						},
+						"available_packages": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The names of the packages with an update available for the VM instance, sorted.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleOSConfigInventoriesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for OSConfigInventories: %s", err)
+	}
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+	instance := d.Get("instance").(string)
+
+	billingProject := project
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/instances/%s/inventories", config.OSConfigBasePath, project, zone, instance)
+	params := map[string]string{"view": d.Get("view").(string)}
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	inventories := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, billingProject, url, userAgent, params, func(res map[string]interface{}) error {
+		list, _ := res["inventories"].([]interface{})
+		for _, raw := range list {
+			inventories = append(inventories, flattenOSConfigInventory(raw.(map[string]interface{})))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing OSConfigInventories: %s", err)
+	}
+
+	if err := d.Set("inventories", inventories); err != nil {
+		return fmt.Errorf("Error setting inventories: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("zone", zone); err != nil {
+		return fmt.Errorf("Error setting zone: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/instances/%s/inventories", project, zone, instance))
+	return nil
+}
+
+func flattenOSConfigInventory(inventory map[string]interface{}) map[string]interface{} {
+	name, _ := inventory["name"].(string)
+	updateTime, _ := inventory["updateTime"].(string)
+
+	instanceID := ""
+	parts := strings.Split(name, "/")
+	for i, part := range parts {
+		if part == "instances" && i+1 < len(parts) {
+			instanceID = parts[i+1]
+		}
+	}
+
+	installed, available := summarizeOSConfigInventoryItems(inventory["items"])
+	return map[string]interface{}{
+		"name":                    name,
+		"instance_id":             instanceID,
+		"update_time":             updateTime,
+		"os_info":                 flattenOSConfigInventoryOsInfo(inventory["osInfo"]),
+		"installed_package_count": installed,
+		"available_package_count": len(available),
+		"available_packages":      available,
+	}
+}
+
+func flattenOSConfigInventoryOsInfo(v interface{}) []interface{} {
+	osInfo, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	transformed := make(map[string]interface{})
+	for field, key := range map[string]string{
+		"hostname":               "hostname",
+		"long_name":              "longName",
+		"short_name":             "shortName",
+		"version":                "version",
+		"architecture":           "architecture",
+		"kernel_version":         "kernelVersion",
+		"kernel_release":         "kernelRelease",
+		"osconfig_agent_version": "osconfigAgentVersion",
+	} {
+		transformed[field], _ = osInfo[key].(string)
+	}
+	return []interface{}{transformed}
+}
+
+// summarizeOSConfigInventoryItems returns the number of installed packages of
+// an inventory, and the sorted names of the packages with an update
+// available. Items are only returned in the FULL view.
+func summarizeOSConfigInventoryItems(v interface{}) (int, []string) {
+	items, _ := v.(map[string]interface{})
+
+	installed := 0
+	available := make([]string, 0)
+	for _, raw := range items {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch item["type"] {
+		case "INSTALLED_PACKAGE":
+			installed++
+		case "AVAILABLE_PACKAGE":
+			if name := osConfigInventoryPackageName(item["availablePackage"]); name != "" {
+				available = append(available, name)
+			}
+		}
+	}
+	sort.Strings(available)
+	return installed, available
+}
+
+// osConfigInventoryPackageName returns the name of a software package of an
+// inventory, whichever package manager it comes from.
+func osConfigInventoryPackageName(v interface{}) string {
+	pkg, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	for _, source := range []struct {
+		kind, field string
+	}{
+		{"yumPackage", "packageName"},
+		{"aptPackage", "packageName"},
+		{"zypperPackage", "packageName"},
+		{"googetPackage", "packageName"},
+		{"cosPackage", "packageName"},
+		{"zypperPatch", "patchName"},
+		{"wuaPackage", "title"},
+		{"qfePackage", "hotFixId"},
+		{"windowsApplication", "displayName"},
+	} {
+		if details, ok := pkg[source.kind].(map[string]interface{}); ok {
+			name, _ := details[source.field].(string)
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,297 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Access changes reported by a replay that remove, or may remove, access that
+// the principal had under the current policy.
+var policySimulatorAccessRemovedChanges = []string{"ACCESS_REVOKED", "ACCESS_MAYBE_REVOKED"}
+
+func dataSourceGooglePolicySimulatorReplay() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGooglePolicySimulatorReplayRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"policy_overlay": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Description: `The proposed IAM policies to simulate. Each entry replaces the current policy of the
+given resource for the duration of the replay.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The full resource name of the resource the policy is attached to, for example
+"//cloudresourcemanager.googleapis.com/projects/my-project".`,
+						},
+						"policy_data": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIamPolicy,
+							Description:  `The proposed policy, as generated by the google_iam_policy data source.`,
+						},
+					},
+				},
+			},
+			"fail_on_access_removal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If true, reading the data source fails when the replay finds an access tuple whose
+access is revoked or may be revoked by the proposed policies.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The resource name of the replay.`,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of access logs replayed.`,
+			},
+			"unchanged_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of replayed logs whose access is unchanged by the proposed policies.`,
+			},
+			"difference_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of replayed logs whose access is changed by the proposed policies.`,
+			},
+			"error_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of replayed logs that could not be evaluated.`,
+			},
+			"access_removed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether any access delta revokes, or may revoke, access.`,
+			},
+			"access_deltas": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The access tuples whose access is changed by the proposed policies.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_change": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The kind of change, such as ACCESS_REVOKED or ACCESS_GAINED.`,
+						},
+						"baseline_access_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"simulated_access_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGooglePolicySimulatorReplayRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	overlay, err := expandPolicySimulatorReplayPolicyOverlay(d.Get("policy_overlay").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{PolicySimulatorBasePath}}projects/{{project}}/locations/global/replays")
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"config": map[string]interface{}{
+			"policyOverlay": overlay,
+			"logSource":     "RECENT_ACCESSES",
+		},
+	}
+	op, err := sendRequestWithTimeout(config, "POST", project, url, userAgent, body, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return fmt.Errorf("Error creating Policy Simulator replay: %s", err)
+	}
+
+	var replay map[string]interface{}
+	err = policySimulatorOperationWaitTimeWithResponse(
+		config, op, &replay, project, "Running Policy Simulator replay", userAgent,
+		d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return fmt.Errorf("Error waiting for Policy Simulator replay: %s", err)
+	}
+
+	name, ok := replay["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf("Error reading Policy Simulator replay: the response has no name")
+	}
+
+	deltas, err := listPolicySimulatorReplayAccessDeltas(config, project, name, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error listing results of Policy Simulator replay %s: %s", name, err)
+	}
+
+	accessRemoved := false
+	for _, delta := range deltas {
+		if stringInSlice(policySimulatorAccessRemovedChanges, delta["access_change"].(string)) {
+			accessRemoved = true
+			break
+		}
+	}
+
+	d.SetId(name)
+	if err := d.Set("name", name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("state", replay["state"]); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
+	}
+	summary, _ := replay["resultsSummary"].(map[string]interface{})
+	for field, key := range map[string]string{
+		"log_count":        "logCount",
+		"unchanged_count":  "unchangedCount",
+		"difference_count": "differenceCount",
+		"error_count":      "errorCount",
+	} {
+		count, _ := summary[key].(float64)
+		if err := d.Set(field, int(count)); err != nil {
+			return fmt.Errorf("Error setting %s: %s", field, err)
+		}
+	}
+	if err := d.Set("access_deltas", deltas); err != nil {
+		return fmt.Errorf("Error setting access_deltas: %s", err)
+	}
+	if err := d.Set("access_removed", accessRemoved); err != nil {
+		return fmt.Errorf("Error setting access_removed: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+
+	if accessRemoved && d.Get("fail_on_access_removal").(bool) {
+		return fmt.Errorf("Policy Simulator replay %s found access removed by the proposed policies: %s",
+			name, summarizePolicySimulatorAccessRemovals(deltas))
+	}
+	return nil
+}
+
+func expandPolicySimulatorReplayPolicyOverlay(v []interface{}) (map[string]interface{}, error) {
+	overlay := make(map[string]interface{}, len(v))
+	for _, raw := range v {
+		entry := raw.(map[string]interface{})
+		resource := entry["resource"].(string)
+		if _, ok := overlay[resource]; ok {
+			return nil, fmt.Errorf("policy_overlay contains more than one policy for resource %q", resource)
+		}
+
+		var policy map[string]interface{}
+		if err := json.Unmarshal([]byte(entry["policy_data"].(string)), &policy); err != nil {
+			return nil, fmt.Errorf("Error parsing policy_data for resource %q: %s", resource, err)
+		}
+		overlay[resource] = policy
+	}
+	return overlay, nil
+}
+
+func listPolicySimulatorReplayAccessDeltas(config *Config, project, replay, userAgent string) ([]map[string]interface{}, error) {
+	url := fmt.Sprintf("%s%s/results", config.PolicySimulatorBasePath, replay)
+
+	deltas := make([]map[string]interface{}, 0)
+	err := listPaginatedItems(config, project, url, userAgent, nil, func(res map[string]interface{}) error {
+		results, _ := res["replayResults"].([]interface{})
+		for _, raw := range results {
+			if delta := flattenPolicySimulatorReplayResult(raw.(map[string]interface{})); delta != nil {
+				deltas = append(deltas, delta)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deltas, nil
+}
+
+// flattenPolicySimulatorReplayResult returns the access delta of a replay result,
+// or nil if the result reports no change or an error.
+func flattenPolicySimulatorReplayResult(result map[string]interface{}) map[string]interface{} {
+	diff, _ := result["diff"].(map[string]interface{})
+	accessDiff, _ := diff["accessDiff"].(map[string]interface{})
+	change, _ := accessDiff["accessChange"].(string)
+	if change == "" || change == "NO_CHANGE" || change == "ACCESS_CHANGE_TYPE_UNSPECIFIED" {
+		return nil
+	}
+
+	tuple, _ := result["accessTuple"].(map[string]interface{})
+	baseline, _ := accessDiff["baseline"].(map[string]interface{})
+	simulated, _ := accessDiff["simulated"].(map[string]interface{})
+	return map[string]interface{}{
+		"principal":              tuple["principal"],
+		"full_resource_name":     tuple["fullResourceName"],
+		"permission":             tuple["permission"],
+		"access_change":          change,
+		"baseline_access_state":  baseline["accessState"],
+		"simulated_access_state": simulated["accessState"],
+	}
+}
+
+func summarizePolicySimulatorAccessRemovals(deltas []map[string]interface{}) string {
+	removals := make([]string, 0)
+	for _, delta := range deltas {
+		if !stringInSlice(policySimulatorAccessRemovedChanges, delta["access_change"].(string)) {
+			continue
+		}
+		removals = append(removals, fmt.Sprintf("%s loses %s on %s (%s)",
+			delta["principal"], delta["permission"], delta["full_resource_name"], delta["access_change"]))
+	}
+	return strings.Join(removals, "; ")
+}
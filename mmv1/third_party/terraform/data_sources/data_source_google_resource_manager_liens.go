@@ -0,0 +1,141 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleResourceManagerLiens() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleResourceManagerLiensRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"restriction": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `If set, only the liens that block this permission are returned, for example "resourcemanager.projects.delete".`,
+			},
+			"fail_if_present": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `If true, reading the data source fails when any matching lien exists.`,
+			},
+			"liens": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"restrictions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleResourceManagerLiensRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ResourceManagerBasePath}}liens")
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"parent": fmt.Sprintf("projects/%s", project),
+	}
+	restriction := d.Get("restriction").(string)
+
+	liens := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if items, ok := res["liens"].([]interface{}); ok {
+			for _, raw := range items {
+				lien := flattenResourceManagerLien(raw.(map[string]interface{}))
+				if restriction != "" && !lienHasRestriction(lien, restriction) {
+					continue
+				}
+				liens = append(liens, lien)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing liens on project %s: %s", project, err)
+	}
+
+	if d.Get("fail_if_present").(bool) && len(liens) > 0 {
+		names := make([]string, 0, len(liens))
+		for _, lien := range liens {
+			names = append(names, fmt.Sprintf("%s (origin %q)", lien["name"], lien["origin"]))
+		}
+		return fmt.Errorf("Project %s has %d lien(s) that must be removed first: %s", project, len(liens), strings.Join(names, ", "))
+	}
+
+	if err := d.Set("liens", liens); err != nil {
+		return fmt.Errorf("Error setting liens: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/liens", project))
+
+	return nil
+}
+
+func flattenResourceManagerLien(lien map[string]interface{}) map[string]interface{} {
+	name, _ := lien["name"].(string)
+	return map[string]interface{}{
+		"name":         strings.TrimPrefix(name, "liens/"),
+		"origin":       lien["origin"],
+		"reason":       lien["reason"],
+		"restrictions": lien["restrictions"],
+		"create_time":  lien["createTime"],
+	}
+}
+
+func lienHasRestriction(lien map[string]interface{}, restriction string) bool {
+	restrictions, _ := lien["restrictions"].([]interface{})
+	for _, r := range restrictions {
+		if r == restriction {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var sccV2FindingsParentKeys = []string{"organization", "folder", "project"}
+
+func dataSourceGoogleSccV2Findings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleSccV2FindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: sccV2FindingsParentKeys,
+				Description:  `The organization to list the findings of, for example "123456789".`,
+			},
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: sccV2FindingsParentKeys,
+				Description:  `The folder to list the findings of, for example "123456789".`,
+			},
+			"project": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: sccV2FindingsParentKeys,
+				Description:  `The ID or number of the project to list the findings of.`,
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "-",
+				Description: `The ID of the source to list the findings of. Defaults to "-", which lists the findings of all sources.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+				Description: `The location of the findings. Defaults to "global".`,
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `If set, only findings of this category are returned, for example "PUBLIC_BUCKET_ACL".`,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CRITICAL", "HIGH", "MEDIUM", "LOW"}, false),
+				Description:  `If set, only findings of this severity are returned.`,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "INACTIVE"}, false),
+				Description:  `If set, only findings in this state are returned.`,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `An additional filter expression, combined with category, severity and state. The syntax is the same
+as for the filter of the findings list API.`,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  `The maximum number of findings to return. Defaults to 100.`,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleSccV2FindingsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	var parent string
+	billingProject := ""
+	if v, ok := d.GetOk("organization"); ok {
+		parent = fmt.Sprintf("organizations/%s", v.(string))
+	} else if v, ok := d.GetOk("folder"); ok {
+		parent = fmt.Sprintf("folders/%s", v.(string))
+	} else {
+		project := d.Get("project").(string)
+		parent = fmt.Sprintf("projects/%s", project)
+		billingProject = project
+	}
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	parent = fmt.Sprintf("%s/sources/%s/locations/%s", parent, d.Get("source").(string), d.Get("location").(string))
+
+	// Findings are only listed in their location through the v2 API.
+	url := removeBasePathVersion(config.SecurityCenterBasePath) + "v2/" + parent + "/findings"
+
+	maxResults := d.Get("max_results").(int)
+	params := map[string]string{
+		"pageSize": strconv.Itoa(maxResults),
+	}
+	if filter := sccV2FindingsFilter(d.Get("filter").(string), d.Get("category").(string), d.Get("severity").(string), d.Get("state").(string)); filter != "" {
+		params["filter"] = filter
+	}
+
+	findings := make([]map[string]interface{}, 0)
+	err = listPaginatedItems(config, billingProject, url, userAgent, params, func(res map[string]interface{}) error {
+		results, _ := res["listFindingsResults"].([]interface{})
+		for _, raw := range results {
+			finding, ok := raw.(map[string]interface{})["finding"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			findings = append(findings, flattenSccV2Finding(finding))
+			if len(findings) == maxResults {
+				return errStopPagination
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing findings of %s: %s", parent, err)
+	}
+
+	if err := d.Set("findings", findings); err != nil {
+		return fmt.Errorf("Error setting findings: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/findings", parent))
+
+	return nil
+}
+
+// sccV2FindingsFilter combines the filter fields of the data source into a single filter expression.
+func sccV2FindingsFilter(filter, category, severity, state string) string {
+	clauses := []string{}
+	if filter != "" {
+		clauses = append(clauses, fmt.Sprintf("(%s)", filter))
+	}
+	if category != "" {
+		clauses = append(clauses, fmt.Sprintf("category=%q", category))
+	}
+	if severity != "" {
+		clauses = append(clauses, fmt.Sprintf("severity=%q", severity))
+	}
+	if state != "" {
+		clauses = append(clauses, fmt.Sprintf("state=%q", state))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+func flattenSccV2Finding(finding map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          finding["name"],
+		"parent":        finding["parent"],
+		"resource_name": finding["resourceName"],
+		"category":      finding["category"],
+		"severity":      finding["severity"],
+		"state":         finding["state"],
+		"finding_class": finding["findingClass"],
+		"mute":          finding["mute"],
+		"description":   finding["description"],
+		"external_uri":  finding["externalUri"],
+		"event_time":    finding["eventTime"],
+		"create_time":   finding["createTime"],
+	}
+}
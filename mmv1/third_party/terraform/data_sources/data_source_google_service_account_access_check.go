@@ -0,0 +1,127 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/iam/v1"
+)
+
+const (
+	serviceAccountGetAccessTokenPermission = "iam.serviceAccounts.getAccessToken"
+	serviceAccountGetOpenIdTokenPermission = "iam.serviceAccounts.getOpenIdToken"
+	serviceAccountSignJwtPermission        = "iam.serviceAccounts.signJwt"
+	serviceAccountKeysCreatePermission     = "iam.serviceAccountKeys.create"
+)
+
+func dataSourceGoogleServiceAccountAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleServiceAccountAccessCheckRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The account id, email or fully qualified name of the service account to check access to. The service account may be in a different project than the caller.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permissions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Additional permissions to test on the service account, such as "iam.serviceAccounts.actAs".`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The fully qualified name of the service account that was checked.`,
+			},
+			"granted_permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The tested permissions that the caller has on the service account.`,
+			},
+			"can_create_access_token": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"can_create_id_token": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"can_sign_jwt": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"can_create_key": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleServiceAccountAccessCheckRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	serviceAccountName, err := serviceAccountFQN(d.Get("account_id").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	permissions := []string{
+		serviceAccountGetAccessTokenPermission,
+		serviceAccountGetOpenIdTokenPermission,
+		serviceAccountSignJwtPermission,
+		serviceAccountKeysCreatePermission,
+	}
+	for _, p := range convertStringArr(d.Get("permissions").([]interface{})) {
+		if !stringInSlice(permissions, p) {
+			permissions = append(permissions, p)
+		}
+	}
+
+	// testIamPermissions reports on the caller, which is the provider's credentials
+	// after any impersonation configured on the provider.
+	req := &iam.TestIamPermissionsRequest{
+		Permissions: permissions,
+	}
+	resp, err := config.NewIamClient(userAgent).Projects.ServiceAccounts.TestIamPermissions(serviceAccountName, req).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", serviceAccountName))
+	}
+
+	granted := resp.Permissions
+	if granted == nil {
+		granted = []string{}
+	}
+
+	d.SetId(serviceAccountName)
+	if err := d.Set("name", serviceAccountName); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("granted_permissions", granted); err != nil {
+		return fmt.Errorf("Error setting granted_permissions: %s", err)
+	}
+	if err := d.Set("can_create_access_token", stringInSlice(granted, serviceAccountGetAccessTokenPermission)); err != nil {
+		return fmt.Errorf("Error setting can_create_access_token: %s", err)
+	}
+	if err := d.Set("can_create_id_token", stringInSlice(granted, serviceAccountGetOpenIdTokenPermission)); err != nil {
+		return fmt.Errorf("Error setting can_create_id_token: %s", err)
+	}
+	if err := d.Set("can_sign_jwt", stringInSlice(granted, serviceAccountSignJwtPermission)); err != nil {
+		return fmt.Errorf("Error setting can_sign_jwt: %s", err)
+	}
+	if err := d.Set("can_create_key", stringInSlice(granted, serviceAccountKeysCreatePermission)); err != nil {
+		return fmt.Errorf("Error setting can_create_key: %s", err)
+	}
+
+	return nil
+}
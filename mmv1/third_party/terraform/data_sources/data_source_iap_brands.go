@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGoogleIapBrands() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIapBrandsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"brands": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"support_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_internal_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIapBrandsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{IapBasePath}}projects/{{project}}/brands")
+	if err != nil {
+		return err
+	}
+
+	// The brands of a project aren't paginated.
+	res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+	if err != nil {
+		return fmt.Errorf("Error listing IAP brands of project %s: %s", project, err)
+	}
+
+	brands := make([]map[string]interface{}, 0)
+	if items, ok := res["brands"].([]interface{}); ok {
+		for _, raw := range items {
+			brands = append(brands, flattenIapBrandsBrand(raw.(map[string]interface{})))
+		}
+	}
+
+	if err := d.Set("brands", brands); err != nil {
+		return fmt.Errorf("Error setting brands: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/brands", project))
+
+	return nil
+}
+
+func flattenIapBrandsBrand(brand map[string]interface{}) map[string]interface{} {
+	orgInternalOnly, _ := brand["orgInternalOnly"].(bool)
+	return map[string]interface{}{
+		"name":              brand["name"],
+		"application_title": brand["applicationTitle"],
+		"support_email":     brand["supportEmail"],
+		"org_internal_only": orgInternalOnly,
+	}
+}
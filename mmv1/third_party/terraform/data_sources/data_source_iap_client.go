@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -9,7 +10,13 @@ import (
 func dataSourceGoogleIapClient() *schema.Resource {
 
 	dsSchema := datasourceSchemaFromResourceSchema(resourceIapClient().Schema)
-	addRequiredFieldsToSchema(dsSchema, "brand", "client_id")
+	addRequiredFieldsToSchema(dsSchema, "brand")
+	addOptionalFieldsToSchema(dsSchema, "client_id", "display_name")
+	// Either field identifies the client, and the other is read from it.
+	dsSchema["client_id"].Computed = true
+	dsSchema["client_id"].ExactlyOneOf = []string{"client_id", "display_name"}
+	dsSchema["display_name"].Computed = true
+	dsSchema["display_name"].ExactlyOneOf = []string{"client_id", "display_name"}
 
 	return &schema.Resource{
 		Read:   dataSourceGoogleIapClientRead,
@@ -20,6 +27,16 @@ func dataSourceGoogleIapClient() *schema.Resource {
 func dataSourceGoogleIapClientRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if displayName, ok := d.GetOk("display_name"); ok && d.Get("client_id").(string) == "" {
+		clientId, err := findIapClientIdByDisplayName(d, config, displayName.(string))
+		if err != nil {
+			return err
+		}
+		if err := d.Set("client_id", clientId); err != nil {
+			return fmt.Errorf("Error setting client_id: %s", err)
+		}
+	}
+
 	id, err := replaceVars(d, config, "{{brand}}/identityAwareProxyClients/{{client_id}}")
 	if err != nil {
 		return fmt.Errorf("Error constructing id: %s", err)
@@ -27,3 +44,48 @@ func dataSourceGoogleIapClientRead(d *schema.ResourceData, meta interface{}) err
 	d.SetId(id)
 	return resourceIapClientRead(d, meta)
 }
+
+// findIapClientIdByDisplayName lists the clients of the brand and returns the
+// id of the only client with the given display name.
+func findIapClientIdByDisplayName(d *schema.ResourceData, config *Config, displayName string) (string, error) {
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return "", err
+	}
+
+	url, err := replaceVars(d, config, "{{IapBasePath}}{{brand}}/identityAwareProxyClients")
+	if err != nil {
+		return "", err
+	}
+
+	billingProject := ""
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	matches := make([]string, 0)
+	err = listPaginatedItems(config, billingProject, url, userAgent, nil, func(res map[string]interface{}) error {
+		if items, ok := res["identityAwareProxyClients"].([]interface{}); ok {
+			for _, raw := range items {
+				client := raw.(map[string]interface{})
+				if client["displayName"] == displayName {
+					name, _ := client["name"].(string)
+					matches = append(matches, GetResourceNameFromSelfLink(name))
+				}
+			}
+		}
+		return nil
+	}, iapClient409Operation)
+	if err != nil {
+		return "", fmt.Errorf("Error listing IAP clients of brand %s: %s", d.Get("brand").(string), err)
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("No IAP client with display name %q found in brand %s", displayName, d.Get("brand").(string))
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("Found %d IAP clients with display name %q in brand %s, use client_id to choose one: %s", len(matches), displayName, d.Get("brand").(string), strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,157 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSpannerDatabases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpannerDatabasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The name of the instance to list the databases of.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_dialect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_retention_period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_leader": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_drop_protection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"kms_key_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Cloud KMS key used to encrypt the database, if it uses customer-managed encryption.`,
+						},
+						"encryption_info": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The encryption state of the database, one entry per key version in use.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"kms_key_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpannerDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{SpannerBasePath}}projects/{{project}}/instances/{{instance}}/databases")
+	if err != nil {
+		return err
+	}
+
+	items, err := listSpannerItems(config, project, url, userAgent, make(map[string]string), "databases")
+	if err != nil {
+		return fmt.Errorf("Error listing Spanner databases: %s", err)
+	}
+
+	databases := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		database := raw.(map[string]interface{})
+		name, _ := database["name"].(string)
+
+		var kmsKeyName interface{}
+		if encryptionConfig, ok := database["encryptionConfig"].(map[string]interface{}); ok {
+			kmsKeyName = encryptionConfig["kmsKeyName"]
+		}
+
+		databases = append(databases, map[string]interface{}{
+			"name":                     GetResourceNameFromSelfLink(name),
+			"state":                    database["state"],
+			"database_dialect":         database["databaseDialect"],
+			"create_time":              database["createTime"],
+			"version_retention_period": database["versionRetentionPeriod"],
+			"default_leader":           database["defaultLeader"],
+			"enable_drop_protection":   database["enableDropProtection"],
+			"kms_key_name":             kmsKeyName,
+			"encryption_info":          flattenDatasourceSpannerDatabasesEncryptionInfo(database["encryptionInfo"]),
+		})
+	}
+
+	if err := d.Set("databases", databases); err != nil {
+		return fmt.Errorf("Error setting databases: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/databases", project, d.Get("instance").(string)))
+
+	return nil
+}
+
+func flattenDatasourceSpannerDatabasesEncryptionInfo(v interface{}) []map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	infos := make([]map[string]interface{}, 0, len(ls))
+	for _, raw := range ls {
+		info := raw.(map[string]interface{})
+		infos = append(infos, map[string]interface{}{
+			"encryption_type": info["encryptionType"],
+			"kms_key_version": info["kmsKeyVersion"],
+		})
+	}
+	return infos
+}
@@ -0,0 +1,134 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSpannerInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSpannerInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `An expression for filtering the instances listed in the response, for
+example "labels.env:prod". See the Spanner API reference for the supported syntax.`,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"processing_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpannerInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{SpannerBasePath}}projects/{{project}}/instances")
+	if err != nil {
+		return err
+	}
+
+	params := make(map[string]string)
+	if filter, ok := d.GetOk("filter"); ok {
+		params["filter"] = filter.(string)
+	}
+
+	items, err := listSpannerItems(config, project, url, userAgent, params, "instances")
+	if err != nil {
+		return fmt.Errorf("Error listing Spanner instances: %s", err)
+	}
+
+	instances := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		instance := raw.(map[string]interface{})
+		name, _ := instance["name"].(string)
+		instanceConfig, _ := instance["config"].(string)
+		instances = append(instances, map[string]interface{}{
+			"name":             GetResourceNameFromSelfLink(name),
+			"display_name":     instance["displayName"],
+			"config":           GetResourceNameFromSelfLink(instanceConfig),
+			"num_nodes":        instance["nodeCount"],
+			"processing_units": instance["processingUnits"],
+			"state":            instance["state"],
+			"labels":           instance["labels"],
+		})
+	}
+
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/instances", project))
+
+	return nil
+}
+
+// listSpannerItems pages through a Spanner list endpoint and returns all items
+// found under key in the responses.
+func listSpannerItems(config *Config, project, url, userAgent string, params map[string]string, key string) ([]interface{}, error) {
+	items := make([]interface{}, 0)
+	err := listPaginatedItems(config, project, url, userAgent, params, func(res map[string]interface{}) error {
+		if ls, ok := res[key].([]interface{}); ok {
+			items = append(items, ls...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,7 @@ func resourceBigtableInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceBigtableInstanceClusterReorderTypeList,
+			resourceBigtableInstanceClusterValidation,
 		),
 
 		SchemaVersion: 1,
@@ -84,6 +86,13 @@ func resourceBigtableInstance() *schema.Resource {
 							Computed:    true,
 							Description: `Describes the Cloud KMS encryption key that will be used to protect the destination Bigtable cluster. The requirements for this key are: 1) The Cloud Bigtable service account associated with the project that contains this cluster must be granted the cloudkms.cryptoKeyEncrypterDecrypter role on the CMEK key. 2) Only regional keys can be used and the region of the CMEK key must match the region of the cluster. 3) All clusters within an instance must use the same CMEK key. Values are of the form projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}`,
 						},
+						"node_scaling_factor": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NodeScalingFactor1X",
+							ValidateFunc: validation.StringInSlice([]string{"NodeScalingFactor1X", "NodeScalingFactor2X"}, false),
+							Description:  `The node scaling factor of the cluster, which sets the increment in which nodes are added and removed. One of "NodeScalingFactor1X" or "NodeScalingFactor2X". With "NodeScalingFactor2X", num_nodes and the autoscaling min_nodes and max_nodes must be multiples of 2. Defaults to "NodeScalingFactor1X". Changing this forces a new cluster to be created.`,
+						},
 						"autoscaling_config": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -92,19 +101,22 @@ func resourceBigtableInstance() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"min_nodes": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: `The minimum number of nodes for autoscaling.`,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  `The minimum number of nodes for autoscaling.`,
 									},
 									"max_nodes": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: `The maximum number of nodes for autoscaling.`,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  `The maximum number of nodes for autoscaling.`,
 									},
 									"cpu_target": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: `The target CPU utilization for autoscaling. Value must be between 10 and 80.`,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(10, 80),
+										Description:  `The target CPU utilization for autoscaling. Value must be between 10 and 80.`,
 									},
 									"storage_target": {
 										Type:        schema.TypeInt,
@@ -392,6 +404,14 @@ func flattenBigtableCluster(c *bigtable.ClusterInfo) map[string]interface{} {
 		"storage_type": storageType,
 		"kms_key_name": c.KMSKeyName,
 	}
+	switch c.NodeScalingFactor {
+	case bigtable.NodeScalingFactor2X:
+		cluster["node_scaling_factor"] = "NodeScalingFactor2X"
+	default:
+		// Clusters created without a node scaling factor report it as unspecified,
+		// which is the same as 1X.
+		cluster["node_scaling_factor"] = "NodeScalingFactor1X"
+	}
 	if c.AutoscalingConfig != nil {
 		cluster["autoscaling_config"] = make([]map[string]interface{}, 1)
 		autoscaling_config := cluster["autoscaling_config"].([]map[string]interface{})
@@ -420,13 +440,22 @@ func expandBigtableClusters(clusters []interface{}, instanceID string, config *C
 			storageType = bigtable.HDD
 		}
 
+		var nodeScalingFactor bigtable.NodeScalingFactor
+		switch cluster["node_scaling_factor"].(string) {
+		case "NodeScalingFactor1X":
+			nodeScalingFactor = bigtable.NodeScalingFactor1X
+		case "NodeScalingFactor2X":
+			nodeScalingFactor = bigtable.NodeScalingFactor2X
+		}
+
 		cluster_config := bigtable.ClusterConfig{
-			InstanceID:  instanceID,
-			Zone:        zone,
-			ClusterID:   cluster["cluster_id"].(string),
-			NumNodes:    int32(cluster["num_nodes"].(int)),
-			StorageType: storageType,
-			KMSKeyName:  cluster["kms_key_name"].(string),
+			InstanceID:        instanceID,
+			Zone:              zone,
+			ClusterID:         cluster["cluster_id"].(string),
+			NumNodes:          int32(cluster["num_nodes"].(int)),
+			StorageType:       storageType,
+			KMSKeyName:        cluster["kms_key_name"].(string),
+			NodeScalingFactor: nodeScalingFactor,
 		}
 		autoscaling_configs := cluster["autoscaling_config"].([]interface{})
 		if len(autoscaling_configs) > 0 {
@@ -532,7 +561,8 @@ func resourceBigtableInstanceClusterReorderTypeList(_ context.Context, diff *sch
 		return fmt.Errorf("Error setting cluster diff: %s", err)
 	}
 
-	// Clusters can't have their zone, storage_type or kms_key_name updated,
+	// Clusters can't have their zone, storage_type, kms_key_name or
+	// node_scaling_factor updated,
 	// ForceNew if it's changed. This will show a diff with the old state on
 	// the left side and the unmodified new state on the right and the ForceNew
 	// attributed to the _old state index_ even if the diff appears to have moved.
@@ -570,8 +600,90 @@ func resourceBigtableInstanceClusterReorderTypeList(_ context.Context, diff *sch
 				return fmt.Errorf("Error setting cluster diff: %s", err)
 			}
 		}
+
+		oNSF, nNSF := diff.GetChange(fmt.Sprintf("cluster.%d.node_scaling_factor", i))
+		// Clusters in state from before node_scaling_factor was added are 1X.
+		if oNSF != nNSF && oNSF != "" {
+			err := diff.ForceNew(fmt.Sprintf("cluster.%d.node_scaling_factor", i))
+			if err != nil {
+				return fmt.Errorf("Error setting cluster diff: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+var bigtableKmsKeyLocationRegex = regexp.MustCompile("^projects/[^/]+/locations/([^/]+)/keyRings/[^/]+/cryptoKeys/[^/]+$")
+
+// resourceBigtableInstanceClusterValidation catches cluster configurations the
+// API would reject only after the instance has started being created or updated.
+func resourceBigtableInstanceClusterValidation(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultZone := ""
+	if config, ok := meta.(*Config); ok {
+		defaultZone = config.Zone
+	}
+
+	clusters, _ := diff.Get("cluster").([]interface{})
+	return validateBigtableClusters(clusters, defaultZone)
+}
+
+func validateBigtableClusters(clusters []interface{}, defaultZone string) error {
+	withKey := 0
+	for _, raw := range clusters {
+		cluster, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		clusterId, _ := cluster["cluster_id"].(string)
+
+		// Every cluster of an instance must be protected by CMEK, or none of them.
+		if kmsKeyName, _ := cluster["kms_key_name"].(string); kmsKeyName != "" {
+			withKey++
+
+			zone, _ := cluster["zone"].(string)
+			if zone == "" {
+				zone = defaultZone
+			}
+			if m := bigtableKmsKeyLocationRegex.FindStringSubmatch(kmsKeyName); m != nil && zone != "" {
+				region := getRegionFromZone(GetResourceNameFromSelfLink(zone))
+				if m[1] != region {
+					return fmt.Errorf("kms_key_name of cluster %q is in location %q, but must be in the region of the cluster, %q", clusterId, m[1], region)
+				}
+			}
+		}
+
+		var autoscalingConfig map[string]interface{}
+		if v, ok := cluster["autoscaling_config"].([]interface{}); ok && len(v) > 0 {
+			autoscalingConfig, _ = v[0].(map[string]interface{})
+		}
+		if autoscalingConfig != nil {
+			minNodes, _ := autoscalingConfig["min_nodes"].(int)
+			maxNodes, _ := autoscalingConfig["max_nodes"].(int)
+			if maxNodes != 0 && minNodes > maxNodes {
+				return fmt.Errorf("autoscaling_config.min_nodes of cluster %q (%d) must not be greater than max_nodes (%d)", clusterId, minNodes, maxNodes)
+			}
+		}
+
+		if nodeScalingFactor, _ := cluster["node_scaling_factor"].(string); nodeScalingFactor == "NodeScalingFactor2X" {
+			counts := map[string]int{}
+			if autoscalingConfig != nil {
+				counts["autoscaling_config.min_nodes"], _ = autoscalingConfig["min_nodes"].(int)
+				counts["autoscaling_config.max_nodes"], _ = autoscalingConfig["max_nodes"].(int)
+			} else {
+				counts["num_nodes"], _ = cluster["num_nodes"].(int)
+			}
+			for _, field := range []string{"num_nodes", "autoscaling_config.min_nodes", "autoscaling_config.max_nodes"} {
+				if count, ok := counts[field]; ok && count%2 != 0 {
+					return fmt.Errorf("%s of cluster %q must be a multiple of 2 with node_scaling_factor NodeScalingFactor2X, got %d", field, clusterId, count)
+				}
+			}
+		}
 	}
 
+	if withKey > 0 && withKey < len(clusters) {
+		return fmt.Errorf("kms_key_name must be set on all clusters of the instance or on none of them")
+	}
 	return nil
 }
 
@@ -0,0 +1,459 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The Cloud Identity API has no batch endpoint for memberships, so the
+// changes needed to reconcile a group are sent as individual requests, at
+// most batch_size of them at a time.
+
+func resourceCloudIdentityGroupMembershipsManager() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudIdentityGroupMembershipsManagerCreate,
+		Read:   resourceCloudIdentityGroupMembershipsManagerRead,
+		Update: resourceCloudIdentityGroupMembershipsManagerUpdate,
+		Delete: resourceCloudIdentityGroupMembershipsManagerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudIdentityGroupMembershipsManagerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `The name of the Group whose memberships are managed, of the form groups/{group_id}.`,
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: `The full set of members of the Group. Members of the Group that aren't listed are removed from it.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The ID of the member. For Google-managed entities, the email address of an existing
+group or user. For external-identity-mapped entities, a string conforming to the Identity Source's requirements.`,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The namespace in which the member exists, of the form identitysources/{identity_source_id},
+for external-identity-mapped entities. Empty for Google-managed entities.`,
+						},
+						"roles": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: `The roles of the member, out of OWNER, MANAGER and MEMBER. They must include MEMBER.`,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"OWNER", "MANAGER", "MEMBER"}, false),
+							},
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The time at which the member's MEMBER role expires and the member is removed from the
+Group, in RFC3339 UTC "Zulu" format. Example: "2014-10-02T15:01:23Z".`,
+						},
+					},
+				},
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  `The maximum number of membership changes sent to the API at the same time.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// cloudIdentityGroupMember is a member of a group, with the expire time of
+// each of its roles keyed by role name.
+type cloudIdentityGroupMember struct {
+	ID        string
+	Namespace string
+	Roles     map[string]string
+	// Name is the resource name of the membership, if it exists.
+	Name string
+}
+
+// cloudIdentityGroupMemberKey identifies a member within a group. Email
+// addresses are case insensitive.
+func cloudIdentityGroupMemberKey(id, namespace string) string {
+	return namespace + "/" + strings.ToLower(id)
+}
+
+func resourceCloudIdentityGroupMembershipsManagerCreate(d *schema.ResourceData, meta interface{}) error {
+	group := d.Get("group").(string)
+	d.SetId(group)
+
+	if err := resourceCloudIdentityGroupMembershipsManagerReconcile(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceCloudIdentityGroupMembershipsManagerRead(d, meta)
+}
+
+func resourceCloudIdentityGroupMembershipsManagerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	current, err := cloudIdentityListGroupMembers(d, config, userAgent, d.Id(), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudIdentityGroupMembershipsManager %q", d.Id()))
+	}
+
+	members := make([]interface{}, 0, len(current))
+	for _, member := range current {
+		members = append(members, flattenCloudIdentityGroupMember(member))
+	}
+
+	if err := d.Set("group", d.Id()); err != nil {
+		return fmt.Errorf("Error setting group: %s", err)
+	}
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf("Error setting members: %s", err)
+	}
+	return nil
+}
+
+func resourceCloudIdentityGroupMembershipsManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("members") {
+		if err := resourceCloudIdentityGroupMembershipsManagerReconcile(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudIdentityGroupMembershipsManagerRead(d, meta)
+}
+
+// resourceCloudIdentityGroupMembershipsManagerDelete removes the members
+// managed by the resource from the group.
+func resourceCloudIdentityGroupMembershipsManagerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	current, err := cloudIdentityListGroupMembers(d, config, userAgent, d.Id(), timeout)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudIdentityGroupMembershipsManager %q", d.Id()))
+	}
+
+	managed, err := expandCloudIdentityGroupMembers(d.Get("members").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	billingProject := cloudIdentityBillingProject(d, config)
+	var ops []func() error
+	for key := range managed {
+		if member, ok := current[key]; ok {
+			op, err := cloudIdentityDeleteGroupMemberOp(d, config, billingProject, userAgent, member, timeout)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, op)
+		}
+	}
+
+	log.Printf("[DEBUG] Removing %d members from CloudIdentityGroup %q", len(ops), d.Id())
+	if err := cloudIdentityRunBatched(ops, d.Get("batch_size").(int)); err != nil {
+		return fmt.Errorf("Error removing members from CloudIdentityGroup %q: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudIdentityGroupMembershipsManagerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The id is the group name, e.g. groups/{group_id}.
+	if !strings.HasPrefix(d.Id(), "groups/") {
+		return nil, fmt.Errorf("Invalid import id %q, expected groups/{group_id}", d.Id())
+	}
+	if err := d.Set("group", d.Id()); err != nil {
+		return nil, fmt.Errorf("Error setting group: %s", err)
+	}
+	if err := d.Set("batch_size", 10); err != nil {
+		return nil, fmt.Errorf("Error setting batch_size: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudIdentityGroupMembershipsManagerReconcile adds, updates and
+// removes the memberships of the group until they match the configuration.
+func resourceCloudIdentityGroupMembershipsManagerReconcile(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	group := d.Get("group").(string)
+	desired, err := expandCloudIdentityGroupMembers(d.Get("members").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	current, err := cloudIdentityListGroupMembers(d, config, userAgent, group, timeout)
+	if err != nil {
+		return fmt.Errorf("Error listing members of CloudIdentityGroup %q: %s", group, err)
+	}
+
+	// The ops run concurrently, so everything they need from d is read here.
+	billingProject := cloudIdentityBillingProject(d, config)
+	var adds, updates, removes []func() error
+	for key, want := range desired {
+		have, ok := current[key]
+		if !ok {
+			op, err := cloudIdentityCreateGroupMemberOp(d, config, billingProject, userAgent, group, want, timeout)
+			if err != nil {
+				return err
+			}
+			adds = append(adds, op)
+			continue
+		}
+		if reqs := cloudIdentityModifyMembershipRolesRequests(have.Roles, want.Roles); len(reqs) > 0 {
+			op, err := cloudIdentityModifyGroupMemberOp(d, config, billingProject, userAgent, have, reqs, timeout)
+			if err != nil {
+				return err
+			}
+			updates = append(updates, op)
+		}
+	}
+	for key, have := range current {
+		if _, ok := desired[key]; !ok {
+			op, err := cloudIdentityDeleteGroupMemberOp(d, config, billingProject, userAgent, have, timeout)
+			if err != nil {
+				return err
+			}
+			removes = append(removes, op)
+		}
+	}
+
+	log.Printf("[DEBUG] Reconciling CloudIdentityGroup %q: adding %d, updating %d and removing %d members", group, len(adds), len(updates), len(removes))
+	batchSize := d.Get("batch_size").(int)
+	if err := cloudIdentityRunBatched(adds, batchSize); err != nil {
+		return fmt.Errorf("Error adding members to CloudIdentityGroup %q: %s", group, err)
+	}
+	if err := cloudIdentityRunBatched(updates, batchSize); err != nil {
+		return fmt.Errorf("Error updating members of CloudIdentityGroup %q: %s", group, err)
+	}
+	if err := cloudIdentityRunBatched(removes, batchSize); err != nil {
+		return fmt.Errorf("Error removing members from CloudIdentityGroup %q: %s", group, err)
+	}
+	return nil
+}
+
+// cloudIdentityRunBatched runs ops batchSize at a time, and returns the
+// errors of all of them.
+func cloudIdentityRunBatched(ops []func() error, batchSize int) error {
+	var mu sync.Mutex
+	var errs *multierror.Error
+
+	for start := 0; start < len(ops); start += batchSize {
+		end := start + batchSize
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		var wg sync.WaitGroup
+		for _, op := range ops[start:end] {
+			wg.Add(1)
+			go func(op func() error) {
+				defer wg.Done()
+				if err := op(); err != nil {
+					mu.Lock()
+					errs = multierror.Append(errs, err)
+					mu.Unlock()
+				}
+			}(op)
+		}
+		wg.Wait()
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func cloudIdentityBillingProject(d *schema.ResourceData, config *Config) string {
+	billingProject := ""
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+	return billingProject
+}
+
+// cloudIdentityListGroupMembers returns the members of a group keyed by
+// cloudIdentityGroupMemberKey.
+func cloudIdentityListGroupMembers(d *schema.ResourceData, config *Config, userAgent, group string, timeout time.Duration) (map[string]*cloudIdentityGroupMember, error) {
+	url, err := replaceVars(d, config, "{{CloudIdentityBasePath}}"+group+"/memberships")
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]*cloudIdentityGroupMember)
+	params := map[string]string{"view": "FULL"}
+	err = sendPaginatedRequest(config, "GET", cloudIdentityBillingProject(d, config), url, userAgent, params, timeout, func(res map[string]interface{}) error {
+		memberships, _ := res["memberships"].([]interface{})
+		for _, raw := range memberships {
+			member := parseCloudIdentityGroupMembership(raw.(map[string]interface{}))
+			members[cloudIdentityGroupMemberKey(member.ID, member.Namespace)] = member
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func parseCloudIdentityGroupMembership(membership map[string]interface{}) *cloudIdentityGroupMember {
+	member := &cloudIdentityGroupMember{Roles: make(map[string]string)}
+	member.Name, _ = membership["name"].(string)
+	if key, ok := membership["preferredMemberKey"].(map[string]interface{}); ok {
+		member.ID, _ = key["id"].(string)
+		member.Namespace, _ = key["namespace"].(string)
+	}
+	roles, _ := membership["roles"].([]interface{})
+	for _, r := range roles {
+		role := r.(map[string]interface{})
+		expireTime := ""
+		if expiryDetail, ok := role["expiryDetail"].(map[string]interface{}); ok {
+			expireTime, _ = expiryDetail["expireTime"].(string)
+		}
+		member.Roles[role["name"].(string)] = expireTime
+	}
+	return member
+}
+
+func flattenCloudIdentityGroupMember(member *cloudIdentityGroupMember) map[string]interface{} {
+	roles := make([]interface{}, 0, len(member.Roles))
+	for _, name := range cloudIdentitySortedRoleNames(member.Roles) {
+		roles = append(roles, name)
+	}
+	return map[string]interface{}{
+		"id":          member.ID,
+		"namespace":   member.Namespace,
+		"roles":       roles,
+		"expire_time": member.Roles["MEMBER"],
+	}
+}
+
+// expandCloudIdentityGroupMembers returns the configured members keyed by
+// cloudIdentityGroupMemberKey. The expire time of a member applies to its
+// MEMBER role.
+func expandCloudIdentityGroupMembers(v []interface{}) (map[string]*cloudIdentityGroupMember, error) {
+	members := make(map[string]*cloudIdentityGroupMember)
+	for _, raw := range v {
+		m := raw.(map[string]interface{})
+		member := &cloudIdentityGroupMember{
+			ID:        m["id"].(string),
+			Namespace: m["namespace"].(string),
+			Roles:     make(map[string]string),
+		}
+		for _, role := range m["roles"].(*schema.Set).List() {
+			member.Roles[role.(string)] = ""
+		}
+		if _, ok := member.Roles["MEMBER"]; !ok {
+			return nil, fmt.Errorf("The roles of member %q must include MEMBER", member.ID)
+		}
+		member.Roles["MEMBER"] = m["expire_time"].(string)
+
+		key := cloudIdentityGroupMemberKey(member.ID, member.Namespace)
+		if _, ok := members[key]; ok {
+			return nil, fmt.Errorf("Member %q is listed more than once", member.ID)
+		}
+		members[key] = member
+	}
+	return members, nil
+}
+
+func cloudIdentityCreateGroupMemberOp(d *schema.ResourceData, config *Config, billingProject, userAgent, group string, member *cloudIdentityGroupMember, timeout time.Duration) (func() error, error) {
+	url, err := replaceVars(d, config, "{{CloudIdentityBasePath}}"+group+"/memberships")
+	if err != nil {
+		return nil, err
+	}
+
+	key := map[string]interface{}{"id": member.ID}
+	if member.Namespace != "" {
+		key["namespace"] = member.Namespace
+	}
+	roles := make([]interface{}, 0, len(member.Roles))
+	for _, name := range cloudIdentitySortedRoleNames(member.Roles) {
+		roles = append(roles, cloudIdentityMembershipRole(name, member.Roles[name]))
+	}
+	obj := map[string]interface{}{
+		"preferredMemberKey": key,
+		"roles":              roles,
+	}
+
+	return func() error {
+		if _, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, obj, timeout); err != nil {
+			return fmt.Errorf("adding %q: %s", member.ID, err)
+		}
+		return nil
+	}, nil
+}
+
+// cloudIdentityModifyGroupMemberOp sends the modifyMembershipRoles requests
+// of a member in order, as roles are added and removed before the others are
+// updated.
+func cloudIdentityModifyGroupMemberOp(d *schema.ResourceData, config *Config, billingProject, userAgent string, member *cloudIdentityGroupMember, reqs []map[string]interface{}, timeout time.Duration) (func() error, error) {
+	url, err := replaceVars(d, config, "{{CloudIdentityBasePath}}"+member.Name+":modifyMembershipRoles")
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		for _, req := range reqs {
+			if _, err := sendRequestWithTimeout(config, "POST", billingProject, url, userAgent, req, timeout); err != nil {
+				return fmt.Errorf("updating the roles of %q: %s", member.ID, err)
+			}
+		}
+		return nil
+	}, nil
+}
+
+func cloudIdentityDeleteGroupMemberOp(d *schema.ResourceData, config *Config, billingProject, userAgent string, member *cloudIdentityGroupMember, timeout time.Duration) (func() error, error) {
+	url, err := replaceVars(d, config, "{{CloudIdentityBasePath}}"+member.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		if _, err := sendRequestWithTimeout(config, "DELETE", billingProject, url, userAgent, nil, timeout); err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				return nil
+			}
+			return fmt.Errorf("removing %q: %s", member.ID, err)
+		}
+		return nil
+	}, nil
+}
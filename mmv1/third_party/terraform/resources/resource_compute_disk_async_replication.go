@@ -0,0 +1,232 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Matches the relative path of a zonal or regional disk.
+var computeAsyncReplicationDiskRegexp = regexp.MustCompile("projects/([^/]+)/(zones|regions)/([^/]+)/disks/([^/]+)$")
+
+func resourceComputeDiskAsyncReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeDiskAsyncReplicationCreate,
+		Read:   resourceComputeDiskAsyncReplicationRead,
+		Delete: resourceComputeDiskAsyncReplicationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"primary_disk": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkRelativePaths,
+				ValidateFunc:     validateRegexp(computeAsyncReplicationDiskRegexp.String()),
+				Description:      `The self link of the primary disk, in the format projects/{project}/zones/{zone}/disks/{disk} or projects/{project}/regions/{region}/disks/{disk}.`,
+			},
+			"secondary_disk": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: `The secondary disk that the primary disk is replicated to.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkRelativePaths,
+							ValidateFunc:     validateRegexp(computeAsyncReplicationDiskRegexp.String()),
+							Description:      `The self link of the secondary disk. The secondary disk must have been created with async_primary_disk set to the primary disk.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the replication to the secondary disk.`,
+						},
+					},
+				},
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceComputeDiskAsyncReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	primary, err := getRelativePath(d.Get("primary_disk").(string))
+	if err != nil {
+		return err
+	}
+	secondary, err := getRelativePath(d.Get("secondary_disk.0.disk").(string))
+	if err != nil {
+		return err
+	}
+	project := computeAsyncReplicationDiskProject(primary)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}"+primary+"/startAsyncReplication")
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{
+		"asyncSecondaryDisk": secondary,
+	}
+
+	log.Printf("[DEBUG] Starting async replication from %s to %s", primary, secondary)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, userAgent, body, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error starting async replication from %s to %s: %s", primary, secondary, err)
+	}
+
+	d.SetId(primary)
+
+	err = computeOperationWaitTime(config, res, project, "Starting async replication", userAgent, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeDiskAsyncReplicationRead(d, meta)
+}
+
+func resourceComputeDiskAsyncReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	primary := d.Id()
+	secondary, err := getRelativePath(d.Get("secondary_disk.0.disk").(string))
+	if err != nil {
+		return err
+	}
+	project := computeAsyncReplicationDiskProject(primary)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}"+primary)
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDiskAsyncReplication %q", d.Id()))
+	}
+
+	state := computeAsyncReplicationSecondaryState(res, secondary)
+	if state == "" || state == "STOPPED" {
+		log.Printf("[WARN] Async replication from %s to %s not found, removing from state", primary, secondary)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("primary_disk", primary); err != nil {
+		return fmt.Errorf("Error setting primary_disk: %s", err)
+	}
+	secondaryDisk := []interface{}{
+		map[string]interface{}{
+			"disk":  secondary,
+			"state": state,
+		},
+	}
+	if err := d.Set("secondary_disk", secondaryDisk); err != nil {
+		return fmt.Errorf("Error setting secondary_disk: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeDiskAsyncReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	primary := d.Id()
+	secondary, err := getRelativePath(d.Get("secondary_disk.0.disk").(string))
+	if err != nil {
+		return err
+	}
+	project := computeAsyncReplicationDiskProject(primary)
+
+	// Replication of a disk in a consistency group can only be stopped for the group as a
+	// whole, so ask the user to take the disk out of the group first.
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}"+primary)
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "GET", project, url, userAgent, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDiskAsyncReplication %q", d.Id()))
+	}
+	policies, _ := res["resourcePolicies"].([]interface{})
+	for _, raw := range policies {
+		policy, err := sendRequest(config, "GET", project, raw.(string), userAgent, nil)
+		if err != nil {
+			return fmt.Errorf("Error reading resource policy %s of disk %s: %s", raw, primary, err)
+		}
+		if _, ok := policy["diskConsistencyGroupPolicy"]; ok {
+			return fmt.Errorf("Disk %s is in consistency group %s. Replication can't be stopped for a single disk of a consistency group; remove the disk from the group first", primary, raw)
+		}
+	}
+
+	// Stopping replication on the secondary disk only stops this pair, unlike stopping it on
+	// the primary disk, which stops the replication to every secondary disk.
+	url, err = replaceVars(d, config, "{{ComputeBasePath}}"+secondary+"/stopAsyncReplication")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Stopping async replication from %s to %s", primary, secondary)
+	res, err = sendRequestWithTimeout(config, "POST", computeAsyncReplicationDiskProject(secondary), url, userAgent, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDiskAsyncReplication %q", d.Id()))
+	}
+
+	err = computeOperationWaitTime(config, res, project, "Stopping async replication", userAgent, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func computeAsyncReplicationDiskProject(disk string) string {
+	parts := computeAsyncReplicationDiskRegexp.FindStringSubmatch(disk)
+	if parts == nil {
+		return ""
+	}
+	return parts[1]
+}
+
+// computeAsyncReplicationSecondaryState returns the replication state of the given secondary disk
+// from the resource status of the primary disk, or "" if the disk isn't a secondary disk of it.
+func computeAsyncReplicationSecondaryState(primary map[string]interface{}, secondary string) string {
+	status, _ := primary["resourceStatus"].(map[string]interface{})
+	disks, _ := status["asyncSecondaryDisks"].(map[string]interface{})
+	for key, raw := range disks {
+		path, err := getRelativePath(key)
+		if err != nil || path != secondary {
+			continue
+		}
+		value, _ := raw.(map[string]interface{})
+		state, _ := value["state"].(string)
+		return state
+	}
+	return ""
+}
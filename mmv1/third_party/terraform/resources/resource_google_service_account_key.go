@@ -1,6 +1,7 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,7 +15,15 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGoogleServiceAccountKeyCreate,
 		Read:   resourceGoogleServiceAccountKeyRead,
+		Update: resourceGoogleServiceAccountKeyUpdate,
 		Delete: resourceGoogleServiceAccountKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceGoogleServiceAccountKeyRotationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			// Required
 			"service_account_id": {
@@ -59,6 +68,18 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNonNegativeDuration(),
+				Description:  `The duration after which the key is due for rotation, for example "720h". Once it has passed, the next plan replaces the key. Use with the create_before_destroy lifecycle setting so the new key is created before the old one is deleted.`,
+			},
+			"rotation_overlap": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNonNegativeDuration(),
+				Description:  `The duration to wait before deleting a key, for example "300s", giving consumers of a replaced key time to switch to the new one. The wait also happens when the key is destroyed, and must be shorter than the delete timeout.`,
+			},
 			// Computed
 			"name": {
 				Type:        schema.TypeString,
@@ -88,6 +109,11 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 				Computed:    true,
 				Description: `The key can be used before this timestamp. A timestamp in RFC3339 UTC "Zulu" format, accurate to nanoseconds. Example: "2014-10-02T15:01:23.045123456Z".`,
 			},
+			"rotation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time after which the key is due for rotation, computed from valid_after and rotation_period. A timestamp in RFC3339 UTC "Zulu" format.`,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -137,6 +163,13 @@ func resourceGoogleServiceAccountKeyCreate(d *schema.ResourceData, meta interfac
 	if err := d.Set("private_key", sak.PrivateKeyData); err != nil {
 		return fmt.Errorf("Error setting private_key: %s", err)
 	}
+	rotationTime, err := serviceAccountKeyRotationTime(sak.ValidAfterTime, d.Get("rotation_period").(string))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("rotation_time", rotationTime); err != nil {
+		return fmt.Errorf("Error setting rotation_time: %s", err)
+	}
 
 	err = serviceAccountKeyWaitTime(config.NewIamClient(userAgent).Projects.ServiceAccounts.Keys, d.Id(), d.Get("public_key_type").(string), "Creating Service account key", 4*time.Minute)
 	if err != nil {
@@ -183,6 +216,19 @@ func resourceGoogleServiceAccountKeyRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// Only the rotation settings can be updated in place, as every other field
+// forces a new key.
+func resourceGoogleServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	rotationTime, err := serviceAccountKeyRotationTime(d.Get("valid_after").(string), d.Get("rotation_period").(string))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("rotation_time", rotationTime); err != nil {
+		return fmt.Errorf("Error setting rotation_time: %s", err)
+	}
+	return resourceGoogleServiceAccountKeyRead(d, meta)
+}
+
 func resourceGoogleServiceAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	userAgent, err := generateUserAgentString(d, config.userAgent)
@@ -190,6 +236,18 @@ func resourceGoogleServiceAccountKeyDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if v, ok := d.GetOk("rotation_overlap"); ok {
+		overlap, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return err
+		}
+		if overlap >= d.Timeout(schema.TimeoutDelete) {
+			return fmt.Errorf("rotation_overlap %s must be shorter than the delete timeout %s", overlap, d.Timeout(schema.TimeoutDelete))
+		}
+		log.Printf("[DEBUG] Waiting %s before deleting service account key %s", overlap, d.Id())
+		time.Sleep(overlap)
+	}
+
 	_, err = config.NewIamClient(userAgent).Projects.ServiceAccounts.Keys.Delete(d.Id()).Do()
 
 	if err != nil {
@@ -210,3 +268,66 @@ func resourceGoogleServiceAccountKeyDelete(d *schema.ResourceData, meta interfac
 	d.SetId("")
 	return nil
 }
+
+// resourceGoogleServiceAccountKeyRotationCustomizeDiff replaces a key whose
+// rotation_time has passed, and keeps rotation_time in sync with
+// rotation_period otherwise.
+func resourceGoogleServiceAccountKeyRotationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Keys being created get their rotation_time set on create.
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotationTime, err := serviceAccountKeyRotationTime(d.Get("valid_after").(string), d.Get("rotation_period").(string))
+	if err != nil {
+		return err
+	}
+
+	if serviceAccountKeyRotationDue(rotationTime, time.Now()) {
+		log.Printf("[DEBUG] Service account key %s is due for rotation since %s", d.Id(), rotationTime)
+		if err := d.SetNewComputed("rotation_time"); err != nil {
+			return err
+		}
+		if d.HasChange("rotation_time") {
+			return d.ForceNew("rotation_time")
+		}
+		// rotation_time was never set, because rotation_period was just
+		// added to a key that is already older than it.
+		return d.ForceNew("rotation_period")
+	}
+
+	if d.Get("rotation_time").(string) != rotationTime {
+		return d.SetNew("rotation_time", rotationTime)
+	}
+	return nil
+}
+
+// serviceAccountKeyRotationTime returns the time at which a key that became
+// valid at validAfter is due for rotation, or an empty string if no rotation
+// period is set.
+func serviceAccountKeyRotationTime(validAfter, rotationPeriod string) (string, error) {
+	if rotationPeriod == "" || validAfter == "" {
+		return "", nil
+	}
+
+	period, err := time.ParseDuration(rotationPeriod)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing rotation_period %q: %s", rotationPeriod, err)
+	}
+	start, err := time.Parse(time.RFC3339, validAfter)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing valid_after %q: %s", validAfter, err)
+	}
+	return start.Add(period).UTC().Format(time.RFC3339), nil
+}
+
+func serviceAccountKeyRotationDue(rotationTime string, now time.Time) bool {
+	if rotationTime == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, rotationTime)
+	if err != nil {
+		return false
+	}
+	return !now.Before(t)
+}
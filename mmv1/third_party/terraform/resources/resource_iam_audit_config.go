@@ -146,6 +146,11 @@ func resourceIamAuditConfigCreateUpdate(newUpdaterFunc newResourceIamUpdaterFunc
 		ac := getResourceIamAuditConfig(d)
 		modifyF := func(ep *cloudresourcemanager.Policy) error {
 			cleaned := removeAllAuditConfigsWithService(ep.AuditConfigs, ac.Service)
+			// If the service was changed in place, drop the config for the old service
+			// so it isn't left behind on the policy.
+			if o, _ := d.GetChange("service"); o.(string) != "" && o.(string) != ac.Service {
+				cleaned = removeAllAuditConfigsWithService(cleaned, o.(string))
+			}
 			ep.AuditConfigs = append(cleaned, ac)
 			return nil
 		}
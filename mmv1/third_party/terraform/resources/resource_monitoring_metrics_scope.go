@@ -0,0 +1,304 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The number of monitored project changes sent before waiting for their
+// operations. The Monitoring API rejects too many concurrent edits of a
+// metrics scope, so changes are applied in bounded batches.
+const metricsScopeBatchSize = 10
+
+var projectNumberRegexp = regexp.MustCompile(`^\d+$`)
+
+func resourceMonitoringMetricsScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMonitoringMetricsScopeCreate,
+		Read:   resourceMonitoringMetricsScopeRead,
+		Update: resourceMonitoringMetricsScopeUpdate,
+		Delete: resourceMonitoringMetricsScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMonitoringMetricsScopeImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scoping_project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID or number of the scoping project of the metrics scope.`,
+			},
+			"monitored_projects": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Description: `The IDs or numbers of the projects monitored by the metrics scope. This list is authoritative:
+monitored projects that are not listed are removed from the metrics scope.`,
+			},
+			"monitored_project_numbers": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `A map from each entry of monitored_projects to its project number.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceMonitoringMetricsScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("locations/global/metricsScopes/%s", d.Get("scoping_project").(string)))
+
+	if err := resourceMonitoringMetricsScopeReconcile(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceMonitoringMetricsScopeRead(d, meta)
+}
+
+func resourceMonitoringMetricsScopeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	scopingProject := d.Get("scoping_project").(string)
+	current, err := listMonitoringMetricsScopeProjects(config, scopingProject, userAgent)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MetricsScope %q", d.Id()))
+	}
+
+	// Keep the identifiers from the configuration for the projects that are
+	// still monitored, and surface any other monitored project by number.
+	numbers := d.Get("monitored_project_numbers").(map[string]interface{})
+	projects := make([]string, 0, len(current))
+	projectNumbers := make(map[string]string, len(current))
+	matched := make(map[string]bool, len(current))
+	for _, p := range d.Get("monitored_projects").(*schema.Set).List() {
+		project := p.(string)
+		number, ok := numbers[project].(string)
+		if !ok || number == "" {
+			number, err = resolveMonitoringProjectNumber(d, config, project, userAgent)
+			if err != nil {
+				return err
+			}
+		}
+		if current[number] && !matched[number] {
+			projects = append(projects, project)
+			projectNumbers[project] = number
+			matched[number] = true
+		}
+	}
+	for number := range current {
+		if !matched[number] {
+			projects = append(projects, number)
+			projectNumbers[number] = number
+		}
+	}
+
+	if err := d.Set("monitored_projects", projects); err != nil {
+		return fmt.Errorf("Error setting monitored_projects: %s", err)
+	}
+	if err := d.Set("monitored_project_numbers", projectNumbers); err != nil {
+		return fmt.Errorf("Error setting monitored_project_numbers: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMonitoringMetricsScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceMonitoringMetricsScopeReconcile(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceMonitoringMetricsScopeRead(d, meta)
+}
+
+func resourceMonitoringMetricsScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	scopingProject := d.Get("scoping_project").(string)
+	current, err := listMonitoringMetricsScopeProjects(config, scopingProject, userAgent)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MetricsScope %q", d.Id()))
+	}
+
+	// The metrics scope itself belongs to the scoping project and can't be
+	// deleted, so deleting the resource removes every monitored project.
+	toRemove := make([]string, 0, len(current))
+	for number := range current {
+		toRemove = append(toRemove, number)
+	}
+	sort.Strings(toRemove)
+
+	return applyMonitoringMetricsScopeChanges(config, scopingProject, userAgent, "DELETE", toRemove, d.Timeout(schema.TimeoutDelete))
+}
+
+func resourceMonitoringMetricsScopeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	parts, err := getImportIdQualifiers([]string{"locations/global/metricsScopes/(?P<scoping_project>[^/]+)", "(?P<scoping_project>[^/]+)"}, d, config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("scoping_project", parts["scoping_project"]); err != nil {
+		return nil, fmt.Errorf("Error setting scoping_project: %s", err)
+	}
+	d.SetId(fmt.Sprintf("locations/global/metricsScopes/%s", parts["scoping_project"]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceMonitoringMetricsScopeReconcile adds and removes monitored projects
+// so that the metrics scope monitors exactly the configured projects.
+func resourceMonitoringMetricsScopeReconcile(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	config := meta.(*Config)
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	scopingProject := d.Get("scoping_project").(string)
+	current, err := listMonitoringMetricsScopeProjects(config, scopingProject, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error reading MetricsScope %q: %s", d.Id(), err)
+	}
+
+	numbers := d.Get("monitored_project_numbers").(map[string]interface{})
+	desired := make(map[string]bool)
+	for _, p := range d.Get("monitored_projects").(*schema.Set).List() {
+		project := p.(string)
+		number, ok := numbers[project].(string)
+		if !ok || number == "" {
+			number, err = resolveMonitoringProjectNumber(d, config, project, userAgent)
+			if err != nil {
+				return err
+			}
+		}
+		desired[number] = true
+	}
+
+	toAdd, toRemove := diffMonitoringMetricsScopeProjects(current, desired)
+	log.Printf("[DEBUG] Reconciling MetricsScope %q: adding %d and removing %d monitored projects", d.Id(), len(toAdd), len(toRemove))
+
+	if err := applyMonitoringMetricsScopeChanges(config, scopingProject, userAgent, "POST", toAdd, timeout); err != nil {
+		return err
+	}
+	return applyMonitoringMetricsScopeChanges(config, scopingProject, userAgent, "DELETE", toRemove, timeout)
+}
+
+// applyMonitoringMetricsScopeChanges adds (POST) or removes (DELETE) the given
+// monitored projects. Each batch of requests is sent before waiting on any of
+// their operations, so the server processes a batch concurrently.
+func applyMonitoringMetricsScopeChanges(config *Config, scopingProject, userAgent, method string, projectNumbers []string, timeout time.Duration) error {
+	activity := "Adding monitored project"
+	if method == "DELETE" {
+		activity = "Removing monitored project"
+	}
+
+	for start := 0; start < len(projectNumbers); start += metricsScopeBatchSize {
+		end := start + metricsScopeBatchSize
+		if end > len(projectNumbers) {
+			end = len(projectNumbers)
+		}
+
+		ops := make(map[string]map[string]interface{}, end-start)
+		for _, number := range projectNumbers[start:end] {
+			name := fmt.Sprintf("locations/global/metricsScopes/%s/projects/%s", scopingProject, number)
+
+			var url string
+			var obj map[string]interface{}
+			if method == "POST" {
+				url = fmt.Sprintf("%sv1/locations/global/metricsScopes/%s/projects", config.MonitoringBasePath, scopingProject)
+				obj = map[string]interface{}{"name": name}
+			} else {
+				url = fmt.Sprintf("%sv1/%s", config.MonitoringBasePath, name)
+			}
+
+			op, err := sendRequestWithTimeout(config, method, scopingProject, url, userAgent, obj, timeout, isMonitoringConcurrentEditError)
+			if err != nil {
+				if method == "DELETE" && isGoogleApiErrorWithCode(err, 404) {
+					continue
+				}
+				return fmt.Errorf("Error %s %s to metrics scope %s: %s", strings.ToLower(activity), number, scopingProject, err)
+			}
+			ops[number] = op
+		}
+
+		for number, op := range ops {
+			if err := monitoringOperationWaitTime(config, op, scopingProject, fmt.Sprintf("%s %s", activity, number), userAgent, timeout); err != nil {
+				return fmt.Errorf("Error %s %s to metrics scope %s: %s", strings.ToLower(activity), number, scopingProject, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// listMonitoringMetricsScopeProjects returns the numbers of the projects
+// monitored by the metrics scope of the scoping project.
+func listMonitoringMetricsScopeProjects(config *Config, scopingProject, userAgent string) (map[string]bool, error) {
+	url := fmt.Sprintf("%sv1/locations/global/metricsScopes/%s", config.MonitoringBasePath, scopingProject)
+	res, err := sendRequest(config, "GET", scopingProject, url, userAgent, nil, isMonitoringConcurrentEditError)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make(map[string]bool)
+	if items, ok := res["monitoredProjects"].([]interface{}); ok {
+		for _, raw := range items {
+			name, _ := raw.(map[string]interface{})["name"].(string)
+			projects[GetResourceNameFromSelfLink(name)] = true
+		}
+	}
+	return projects, nil
+}
+
+func diffMonitoringMetricsScopeProjects(current, desired map[string]bool) ([]string, []string) {
+	toAdd := make([]string, 0)
+	for number := range desired {
+		if !current[number] {
+			toAdd = append(toAdd, number)
+		}
+	}
+	toRemove := make([]string, 0)
+	for number := range current {
+		if !desired[number] {
+			toRemove = append(toRemove, number)
+		}
+	}
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	return toAdd, toRemove
+}
+
+// The metrics scope API identifies monitored projects by number, so resolve
+// project IDs to compare them with the API response.
+func resolveMonitoringProjectNumber(d *schema.ResourceData, config *Config, project, userAgent string) (string, error) {
+	if projectNumberRegexp.MatchString(project) {
+		return project, nil
+	}
+	return getProjectNumber(d, config, project, userAgent)
+}
@@ -74,6 +74,22 @@ func resourceSqlUser() *schema.Resource {
                 either CLOUD_IAM_USER or CLOUD_IAM_SERVICE_ACCOUNT.`,
 			},
 
+			"password_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"password"},
+				DiffSuppressFunc: writeOnlyDiffSuppress,
+				Description: `The password for the user, which isn't stored in the Terraform state. Changes to it are ignored,
+                change password_wo_version to send its current value.`,
+			},
+
+			"password_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `Triggers sending the current value of password_wo to the API when changed.`,
+			},
+
 			"type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -220,7 +236,10 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 	instance := d.Get("instance").(string)
-	password := d.Get("password").(string)
+	password, err := sqlUserPassword(d)
+	if err != nil {
+		return err
+	}
 	host := d.Get("host").(string)
 	typ := d.Get("type").(string)
 
@@ -347,10 +366,27 @@ func resourceSqlUserRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := clearWriteOnlyField(d, "password_wo"); err != nil {
+		return err
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s/%s", user.Name, user.Host, user.Instance))
 	return nil
 }
 
+// sqlUserPassword returns the password to send to the API, from either
+// password or the write-only password_wo.
+func sqlUserPassword(d *schema.ResourceData) (string, error) {
+	v, err := writeOnlyConfigValue(d, "password_wo", d.Get("password_wo"))
+	if err != nil {
+		return "", err
+	}
+	if password, ok := v.(string); ok && password != "" {
+		return password, nil
+	}
+	return d.Get("password").(string), nil
+}
+
 func flattenPasswordPolicy(passwordPolicy *sqladmin.UserPasswordValidationPolicy) interface{} {
 	data := map[string]interface{}{}
 	if passwordPolicy.AllowedFailedAttempts != 0 {
@@ -397,7 +433,7 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if d.HasChange("password") || d.HasChange("password_policy") {
+	if d.HasChange("password") || d.HasChange("password_wo_version") || d.HasChange("password_policy") {
 		project, err := getProject(d, config)
 		if err != nil {
 			return err
@@ -405,7 +441,10 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		name := d.Get("name").(string)
 		instance := d.Get("instance").(string)
-		password := d.Get("password").(string)
+		password, err := sqlUserPassword(d)
+		if err != nil {
+			return err
+		}
 		host := d.Get("host").(string)
 
 		user := &sqladmin.User{
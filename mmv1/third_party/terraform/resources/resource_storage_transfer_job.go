@@ -14,18 +14,9 @@ import (
 )
 
 var (
-	objectConditionsKeys = []string{
-		"transfer_spec.0.object_conditions.0.min_time_elapsed_since_last_modification",
-		"transfer_spec.0.object_conditions.0.max_time_elapsed_since_last_modification",
-		"transfer_spec.0.object_conditions.0.include_prefixes",
-		"transfer_spec.0.object_conditions.0.exclude_prefixes",
-	}
-
-	transferOptionsKeys = []string{
-		"transfer_spec.0.transfer_options.0.overwrite_objects_already_existing_in_sink",
-		"transfer_spec.0.transfer_options.0.delete_objects_unique_in_sink",
-		"transfer_spec.0.transfer_options.0.delete_objects_from_source_after_transfer",
-		"transfer_spec.0.transfer_options.0.overwrite_when",
+	transferJobSpecKeys = []string{
+		"transfer_spec",
+		"replication_spec",
 	}
 
 	transferSpecDataSourceKeys = []string{
@@ -39,12 +30,40 @@ var (
 		"transfer_spec.0.gcs_data_sink",
 		"transfer_spec.0.posix_data_sink",
 	}
+	replicationSpecDataSourceKeys = []string{
+		"replication_spec.0.gcs_data_source",
+	}
+	replicationSpecDataSinkKeys = []string{
+		"replication_spec.0.gcs_data_sink",
+	}
 	awsS3AuthKeys = []string{
 		"transfer_spec.0.aws_s3_data_source.0.aws_access_key",
 		"transfer_spec.0.aws_s3_data_source.0.role_arn",
 	}
 )
 
+// objectConditionsKeys returns the object_conditions fields of the
+// transfer_spec or replication_spec block named by spec.
+func objectConditionsKeys(spec string) []string {
+	return []string{
+		spec + ".0.object_conditions.0.min_time_elapsed_since_last_modification",
+		spec + ".0.object_conditions.0.max_time_elapsed_since_last_modification",
+		spec + ".0.object_conditions.0.include_prefixes",
+		spec + ".0.object_conditions.0.exclude_prefixes",
+	}
+}
+
+// transferOptionsKeys returns the transfer_options fields of the
+// transfer_spec or replication_spec block named by spec.
+func transferOptionsKeys(spec string) []string {
+	return []string{
+		spec + ".0.transfer_options.0.overwrite_objects_already_existing_in_sink",
+		spec + ".0.transfer_options.0.delete_objects_unique_in_sink",
+		spec + ".0.transfer_options.0.delete_objects_from_source_after_transfer",
+		spec + ".0.transfer_options.0.overwrite_when",
+	}
+}
+
 func resourceStorageTransferJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStorageTransferJobCreate,
@@ -75,13 +94,26 @@ func resourceStorageTransferJob() *schema.Resource {
 				Description: `The project in which the resource belongs. If it is not provided, the provider project is used.`,
 			},
 			"transfer_spec": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: transferJobSpecKeys,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"object_conditions": objectConditionsSchema(),
-						"transfer_options":  transferOptionsSchema(),
+						"object_conditions": objectConditionsSchema("transfer_spec"),
+						"transfer_options":  transferOptionsSchema("transfer_spec"),
+						"source_agent_pool_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies the agent pool name associated with the POSIX data source. When unspecified, the default name is used.`,
+						},
+						"sink_agent_pool_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies the agent pool name associated with the POSIX data sink. When unspecified, the default name is used.`,
+						},
 						"gcs_data_sink": {
 							Type:         schema.TypeList,
 							Optional:     true,
@@ -142,6 +174,94 @@ func resourceStorageTransferJob() *schema.Resource {
 				},
 				Description: `Transfer specification.`,
 			},
+			"replication_spec": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: transferJobSpecKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_conditions": objectConditionsSchema("replication_spec"),
+						"transfer_options":  transferOptionsSchema("replication_spec"),
+						"gcs_data_sink": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         gcsDataSchema(),
+							ExactlyOneOf: replicationSpecDataSinkKeys,
+							Description:  `A Google Cloud Storage data sink.`,
+						},
+						"gcs_data_source": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         gcsDataSchema(),
+							ExactlyOneOf: replicationSpecDataSourceKeys,
+							Description:  `A Google Cloud Storage data source.`,
+						},
+					},
+				},
+				Description: `Replication specification. New objects written to the source bucket are continuously replicated to the sink bucket.`,
+			},
+			"event_stream": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies a unique name of the resource such as AWS SQS ARN in the form 'arn:aws:sqs:region:account_id:queue_name', or Pub/Sub subscription resource name in the form 'projects/{project}/subscriptions/{sub}'.`,
+						},
+						"event_stream_start_time": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the date and time that Storage Transfer Service starts listening for events from this stream. If no start time is specified or start time is in the past, Storage Transfer Service starts listening immediately. A timestamp in RFC3339 UTC "Zulu" format.`,
+						},
+						"event_stream_expiration_time": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the data and time at which Storage Transfer Service stops listening for events from this stream. After this time, any transfers in progress will complete, but no new transfers are initiated. A timestamp in RFC3339 UTC "Zulu" format.`,
+						},
+					},
+				},
+				Description: `Specifies the Event-driven transfer options. Event-driven transfers listen to an event stream to transfer updated files.`,
+			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"FIND", "DELETE", "COPY"}, false),
+							},
+							Description: `Specifies the actions to be logged. Not supported for transfers with PosixFilesystem data sources; use enable_onprem_gcs_transfer_logs instead. The valid actions are "FIND", "DELETE" and "COPY".`,
+						},
+						"log_action_states": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"SUCCEEDED", "FAILED"}, false),
+							},
+							Description: `States in which log_actions are logged. Not supported for transfers with PosixFilesystem data sources; use enable_onprem_gcs_transfer_logs instead. The valid states are "SUCCEEDED" and "FAILED".`,
+						},
+						"enable_onprem_gcs_transfer_logs": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `For transfers with a PosixFilesystem source, this option enables the Cloud Storage transfer logs for this transfer.`,
+						},
+					},
+				},
+				Description: `Logging configuration.`,
+			},
 			"notification_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -238,7 +358,7 @@ func resourceStorageTransferJob() *schema.Resource {
 	}
 }
 
-func objectConditionsSchema() *schema.Schema {
+func objectConditionsSchema(spec string) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -249,20 +369,20 @@ func objectConditionsSchema() *schema.Schema {
 					Type:         schema.TypeString,
 					ValidateFunc: validateDuration(),
 					Optional:     true,
-					AtLeastOneOf: objectConditionsKeys,
+					AtLeastOneOf: objectConditionsKeys(spec),
 					Description:  `A duration in seconds with up to nine fractional digits, terminated by 's'. Example: "3.5s".`,
 				},
 				"max_time_elapsed_since_last_modification": {
 					Type:         schema.TypeString,
 					ValidateFunc: validateDuration(),
 					Optional:     true,
-					AtLeastOneOf: objectConditionsKeys,
+					AtLeastOneOf: objectConditionsKeys(spec),
 					Description:  `A duration in seconds with up to nine fractional digits, terminated by 's'. Example: "3.5s".`,
 				},
 				"include_prefixes": {
 					Type:         schema.TypeList,
 					Optional:     true,
-					AtLeastOneOf: objectConditionsKeys,
+					AtLeastOneOf: objectConditionsKeys(spec),
 					Elem: &schema.Schema{
 						MaxItems: 1000,
 						Type:     schema.TypeString,
@@ -272,7 +392,7 @@ func objectConditionsSchema() *schema.Schema {
 				"exclude_prefixes": {
 					Type:         schema.TypeList,
 					Optional:     true,
-					AtLeastOneOf: objectConditionsKeys,
+					AtLeastOneOf: objectConditionsKeys(spec),
 					Elem: &schema.Schema{
 						MaxItems: 1000,
 						Type:     schema.TypeString,
@@ -285,7 +405,7 @@ func objectConditionsSchema() *schema.Schema {
 	}
 }
 
-func transferOptionsSchema() *schema.Schema {
+func transferOptionsSchema(spec string) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
@@ -295,27 +415,27 @@ func transferOptionsSchema() *schema.Schema {
 				"overwrite_objects_already_existing_in_sink": {
 					Type:         schema.TypeBool,
 					Optional:     true,
-					AtLeastOneOf: transferOptionsKeys,
+					AtLeastOneOf: transferOptionsKeys(spec),
 					Description:  `Whether overwriting objects that already exist in the sink is allowed.`,
 				},
 				"delete_objects_unique_in_sink": {
 					Type:          schema.TypeBool,
 					Optional:      true,
-					AtLeastOneOf:  transferOptionsKeys,
-					ConflictsWith: []string{"transfer_spec.transfer_options.delete_objects_from_source_after_transfer"},
+					AtLeastOneOf:  transferOptionsKeys(spec),
+					ConflictsWith: []string{spec + ".transfer_options.delete_objects_from_source_after_transfer"},
 					Description:   `Whether objects that exist only in the sink should be deleted. Note that this option and delete_objects_from_source_after_transfer are mutually exclusive.`,
 				},
 				"delete_objects_from_source_after_transfer": {
 					Type:          schema.TypeBool,
 					Optional:      true,
-					AtLeastOneOf:  transferOptionsKeys,
-					ConflictsWith: []string{"transfer_spec.transfer_options.delete_objects_unique_in_sink"},
+					AtLeastOneOf:  transferOptionsKeys(spec),
+					ConflictsWith: []string{spec + ".transfer_options.delete_objects_unique_in_sink"},
 					Description:   `Whether objects should be deleted from the source after they are transferred to the sink. Note that this option and delete_objects_unique_in_sink are mutually exclusive.`,
 				},
 				"overwrite_when": {
 					Type:         schema.TypeString,
 					Optional:     true,
-					AtLeastOneOf: transferOptionsKeys,
+					AtLeastOneOf: transferOptionsKeys(spec),
 					ValidateFunc: validation.StringInSlice([]string{"DIFFERENT", "NEVER", "ALWAYS"}, false),
 					Description:  `When to overwrite objects that already exist in the sink. If not set, overwrite behavior is determined by overwriteObjectsAlreadyExistingInSink.`,
 				},
@@ -527,6 +647,9 @@ func resourceStorageTransferJobCreate(d *schema.ResourceData, meta interface{})
 		Status:             d.Get("status").(string),
 		Schedule:           expandTransferSchedules(d.Get("schedule").([]interface{})),
 		TransferSpec:       expandTransferSpecs(d.Get("transfer_spec").([]interface{})),
+		ReplicationSpec:    expandReplicationSpecs(d.Get("replication_spec").([]interface{})),
+		EventStream:        expandEventStream(d.Get("event_stream").([]interface{})),
+		LoggingConfig:      expandTransferJobLoggingConfig(d.Get("logging_config").([]interface{})),
 		NotificationConfig: expandTransferJobNotificationConfig(d.Get("notification_config").([]interface{})),
 	}
 
@@ -604,6 +727,21 @@ func resourceStorageTransferJobRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	err = d.Set("replication_spec", flattenReplicationSpec(res.ReplicationSpec))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("event_stream", flattenEventStream(res.EventStream))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("logging_config", flattenTransferJobLoggingConfig(res.LoggingConfig))
+	if err != nil {
+		return err
+	}
+
 	err = d.Set("notification_config", flattenTransferJobNotificationConfig(res.NotificationConfig))
 	if err != nil {
 		return err
@@ -655,6 +793,27 @@ func resourceStorageTransferJobUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("replication_spec") {
+		fieldMask = append(fieldMask, "replication_spec")
+		if v, ok := d.GetOk("replication_spec"); ok {
+			transferJob.ReplicationSpec = expandReplicationSpecs(v.([]interface{}))
+		}
+	}
+
+	if d.HasChange("event_stream") {
+		fieldMask = append(fieldMask, "event_stream")
+		if v, ok := d.GetOk("event_stream"); ok {
+			transferJob.EventStream = expandEventStream(v.([]interface{}))
+		}
+	}
+
+	if d.HasChange("logging_config") {
+		fieldMask = append(fieldMask, "logging_config")
+		if v, ok := d.GetOk("logging_config"); ok {
+			transferJob.LoggingConfig = expandTransferJobLoggingConfig(v.([]interface{}))
+		}
+	}
+
 	if d.HasChange("notification_config") {
 		fieldMask = append(fieldMask, "notification_config")
 		if v, ok := d.GetOk("notification_config"); ok {
@@ -1064,6 +1223,8 @@ func expandTransferSpecs(transferSpecs []interface{}) *storagetransfer.TransferS
 
 	transferSpec := transferSpecs[0].(map[string]interface{})
 	return &storagetransfer.TransferSpec{
+		SourceAgentPoolName:        transferSpec["source_agent_pool_name"].(string),
+		SinkAgentPoolName:          transferSpec["sink_agent_pool_name"].(string),
 		GcsDataSink:                expandGcsData(transferSpec["gcs_data_sink"].([]interface{})),
 		PosixDataSink:              expandPosixData(transferSpec["posix_data_sink"].([]interface{})),
 		ObjectConditions:           expandObjectConditions(transferSpec["object_conditions"].([]interface{})),
@@ -1076,8 +1237,15 @@ func expandTransferSpecs(transferSpecs []interface{}) *storagetransfer.TransferS
 	}
 }
 
-func flattenTransferSpec(transferSpec *storagetransfer.TransferSpec, d *schema.ResourceData) []map[string][]map[string]interface{} {
-	data := map[string][]map[string]interface{}{}
+func flattenTransferSpec(transferSpec *storagetransfer.TransferSpec, d *schema.ResourceData) []map[string]interface{} {
+	if transferSpec == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"source_agent_pool_name": transferSpec.SourceAgentPoolName,
+		"sink_agent_pool_name":   transferSpec.SinkAgentPoolName,
+	}
 	if transferSpec.GcsDataSink != nil {
 		data["gcs_data_sink"] = flattenGcsData(transferSpec.GcsDataSink)
 	}
@@ -1105,7 +1273,70 @@ func flattenTransferSpec(transferSpec *storagetransfer.TransferSpec, d *schema.R
 		data["posix_data_source"] = flattenPosixData(transferSpec.PosixDataSource)
 	}
 
-	return []map[string][]map[string]interface{}{data}
+	return []map[string]interface{}{data}
+}
+
+func expandReplicationSpecs(replicationSpecs []interface{}) *storagetransfer.ReplicationSpec {
+	if len(replicationSpecs) == 0 || replicationSpecs[0] == nil {
+		return nil
+	}
+
+	replicationSpec := replicationSpecs[0].(map[string]interface{})
+	return &storagetransfer.ReplicationSpec{
+		GcsDataSink:      expandGcsData(replicationSpec["gcs_data_sink"].([]interface{})),
+		GcsDataSource:    expandGcsData(replicationSpec["gcs_data_source"].([]interface{})),
+		ObjectConditions: expandObjectConditions(replicationSpec["object_conditions"].([]interface{})),
+		TransferOptions:  expandTransferOptions(replicationSpec["transfer_options"].([]interface{})),
+	}
+}
+
+func flattenReplicationSpec(replicationSpec *storagetransfer.ReplicationSpec) []map[string]interface{} {
+	if replicationSpec == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{}
+	if replicationSpec.GcsDataSink != nil {
+		data["gcs_data_sink"] = flattenGcsData(replicationSpec.GcsDataSink)
+	}
+	if replicationSpec.GcsDataSource != nil {
+		data["gcs_data_source"] = flattenGcsData(replicationSpec.GcsDataSource)
+	}
+	if replicationSpec.ObjectConditions != nil {
+		data["object_conditions"] = flattenObjectCondition(replicationSpec.ObjectConditions)
+	}
+	if replicationSpec.TransferOptions != nil {
+		data["transfer_options"] = flattenTransferOption(replicationSpec.TransferOptions)
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func expandEventStream(eventStreams []interface{}) *storagetransfer.EventStream {
+	if len(eventStreams) == 0 || eventStreams[0] == nil {
+		return nil
+	}
+
+	eventStream := eventStreams[0].(map[string]interface{})
+	return &storagetransfer.EventStream{
+		Name:                      eventStream["name"].(string),
+		EventStreamStartTime:      eventStream["event_stream_start_time"].(string),
+		EventStreamExpirationTime: eventStream["event_stream_expiration_time"].(string),
+	}
+}
+
+func flattenEventStream(eventStream *storagetransfer.EventStream) []map[string]interface{} {
+	if eventStream == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"name":                         eventStream.Name,
+		"event_stream_start_time":      eventStream.EventStreamStartTime,
+		"event_stream_expiration_time": eventStream.EventStreamExpirationTime,
+	}
+
+	return []map[string]interface{}{data}
 }
 
 func usingPosix(transferSpec *storagetransfer.TransferSpec) bool {
@@ -1147,3 +1378,37 @@ func flattenTransferJobNotificationConfig(notificationConfig *storagetransfer.No
 
 	return []map[string]interface{}{data}
 }
+
+func expandTransferJobLoggingConfig(loggingConfigs []interface{}) *storagetransfer.LoggingConfig {
+	if len(loggingConfigs) == 0 || loggingConfigs[0] == nil {
+		return nil
+	}
+
+	loggingConfig := loggingConfigs[0].(map[string]interface{})
+	var apiData = &storagetransfer.LoggingConfig{
+		EnableOnpremGcsTransferLogs: loggingConfig["enable_onprem_gcs_transfer_logs"].(bool),
+	}
+
+	if loggingConfig["log_actions"] != nil {
+		apiData.LogActions = convertStringArr(loggingConfig["log_actions"].(*schema.Set).List())
+	}
+	if loggingConfig["log_action_states"] != nil {
+		apiData.LogActionStates = convertStringArr(loggingConfig["log_action_states"].(*schema.Set).List())
+	}
+
+	return apiData
+}
+
+func flattenTransferJobLoggingConfig(loggingConfig *storagetransfer.LoggingConfig) []map[string]interface{} {
+	if loggingConfig == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"enable_onprem_gcs_transfer_logs": loggingConfig.EnableOnpremGcsTransferLogs,
+		"log_actions":                     convertStringArrToInterface(loggingConfig.LogActions),
+		"log_action_states":               convertStringArrToInterface(loggingConfig.LogActionStates),
+	}
+
+	return []map[string]interface{}{data}
+}
@@ -0,0 +1,125 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceGoogleCloudRunV2Job_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleCloudRunV2Job_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					checkDataSourceStateMatchesResourceState("data.google_cloud_run_v2_job.default", "google_cloud_run_v2_job.default"),
+					// No execution has been started yet
+					resource.TestCheckResourceAttr("data.google_cloud_run_v2_job.default", "latest_execution_status.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleCloudRunV2Jobs_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleCloudRunV2Jobs_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudRunV2JobListed("data.google_cloud_run_v2_jobs.all", fmt.Sprintf("tf-test-cloudrun-job%s", context["random_suffix"])),
+				),
+			},
+		},
+	})
+}
+
+// Other jobs may exist in the test project, so only check that the job created
+// by the test is part of the list.
+func testAccCheckCloudRunV2JobListed(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(ds.Primary.Attributes["jobs.#"])
+		if err != nil {
+			return fmt.Errorf("Error reading jobs count: %s", err)
+		}
+
+		for i := 0; i < count; i++ {
+			if ds.Primary.Attributes[fmt.Sprintf("jobs.%d.name", i)] == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("Job %q not found in %s", name, n)
+	}
+}
+
+func testAccDataSourceGoogleCloudRunV2Job_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name         = "tf-test-cloudrun-job%{random_suffix}"
+  location     = "us-central1"
+  launch_stage = "BETA"
+
+  template {
+    template {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+}
+
+data "google_cloud_run_v2_job" "default" {
+  name     = google_cloud_run_v2_job.default.name
+  location = google_cloud_run_v2_job.default.location
+}
+`, context)
+}
+
+func testAccDataSourceGoogleCloudRunV2Jobs_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name         = "tf-test-cloudrun-job%{random_suffix}"
+  location     = "us-central1"
+  launch_stage = "BETA"
+
+  template {
+    template {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+}
+
+data "google_cloud_run_v2_jobs" "all" {
+  location   = "us-central1"
+  depends_on = [google_cloud_run_v2_job.default]
+}
+`, context)
+}
@@ -0,0 +1,82 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceComputeReservations_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservationDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeReservations_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					// Without a zone, the reservations of every zone are listed.
+					resource.TestCheckResourceAttr("data.google_compute_reservations.all", "reservations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_reservations.all", "reservations.*", map[string]string{
+						"name": "tf-test-reservation-a%{random_suffix}",
+						"zone": "us-central1-a",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_reservations.all", "reservations.*", map[string]string{
+						"name": "tf-test-reservation-b%{random_suffix}",
+						"zone": "us-central1-b",
+					}),
+					resource.TestCheckResourceAttr("data.google_compute_reservations.zonal", "reservations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_reservations.zonal", "reservations.0.self_link", "google_compute_reservation.b", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_reservations.zonal", "reservations.0.zone", "us-central1-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeReservations_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_reservation" "a" {
+  name = "tf-test-reservation-a%{random_suffix}"
+  zone = "us-central1-a"
+
+  specific_reservation {
+    count = 1
+    instance_properties {
+      machine_type = "n2-standard-2"
+    }
+  }
+}
+
+resource "google_compute_reservation" "b" {
+  name = "tf-test-reservation-b%{random_suffix}"
+  zone = "us-central1-b"
+
+  specific_reservation {
+    count = 1
+    instance_properties {
+      machine_type = "n2-standard-2"
+    }
+  }
+}
+
+data "google_compute_reservations" "all" {
+  filter = "name:%{random_suffix}"
+
+  depends_on = [google_compute_reservation.a, google_compute_reservation.b]
+}
+
+data "google_compute_reservations" "zonal" {
+  zone   = "us-central1-b"
+  filter = "name:%{random_suffix}"
+
+  depends_on = [google_compute_reservation.a, google_compute_reservation.b]
+}
+`, context)
+}
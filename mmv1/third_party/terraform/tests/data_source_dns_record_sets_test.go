@@ -0,0 +1,82 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDnsRecordSets_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := randString(t, 10)
+	recordSetName := randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsRecordSetDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDnsRecordSets_basic(zoneName, recordSetName),
+				Check: resource.ComposeTestCheckFunc(
+					// The zone's SOA and NS record sets are listed along with the ones created here.
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.all", "rrsets.#", "4"),
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.a", "rrsets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_dns_record_sets.a", "rrsets.0.name", "google_dns_record_set.a", "name"),
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.a", "rrsets.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.a", "rrsets.0.rrdatas.0", "192.168.1.0"),
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.txt", "rrsets.#", "1"),
+					resource.TestCheckResourceAttr("data.google_dns_record_sets.txt", "rrsets.0.type", "TXT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDnsRecordSets_basic(zoneName, recordSetName string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "zone" {
+  name     = "tf-test-zone-%s"
+  dns_name = "%s.hashicorptest.com."
+}
+
+resource "google_dns_record_set" "a" {
+  managed_zone = google_dns_managed_zone.zone.name
+  name         = "%s.${google_dns_managed_zone.zone.dns_name}"
+  type         = "A"
+  ttl          = 300
+  rrdatas      = ["192.168.1.0"]
+}
+
+resource "google_dns_record_set" "txt" {
+  managed_zone = google_dns_managed_zone.zone.name
+  name         = "%s.${google_dns_managed_zone.zone.dns_name}"
+  type         = "TXT"
+  ttl          = 300
+  rrdatas      = ["\"hello\""]
+}
+
+data "google_dns_record_sets" "all" {
+  managed_zone = google_dns_managed_zone.zone.name
+
+  depends_on = [google_dns_record_set.a, google_dns_record_set.txt]
+}
+
+data "google_dns_record_sets" "a" {
+  managed_zone = google_dns_managed_zone.zone.name
+  name         = google_dns_record_set.a.name
+  type         = "A"
+
+  depends_on = [google_dns_record_set.a, google_dns_record_set.txt]
+}
+
+data "google_dns_record_sets" "txt" {
+  managed_zone = google_dns_managed_zone.zone.name
+  type         = "TXT"
+
+  depends_on = [google_dns_record_set.a, google_dns_record_set.txt]
+}
+`, zoneName, zoneName, recordSetName, recordSetName)
+}
@@ -0,0 +1,93 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleApigeeEnvironments_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigeeEnvironmentDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleApigeeEnvironments_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_apigee_environments.envs", "state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_apigee_environments.envs", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.google_apigee_environments.envs", "environments.0.name", "tf-test"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_apigee_environments.envs", "environments.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("data.google_apigee_environments.envs", "environments.0.id", "google_apigee_environment.env", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleApigeeEnvironments_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "project" {
+  project_id      = "tf-test%{random_suffix}"
+  name            = "tf-test%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "apigee" {
+  project = google_project.project.project_id
+  service = "apigee.googleapis.com"
+}
+
+resource "google_apigee_organization" "apigee_org" {
+  analytics_region = "us-central1"
+  project_id       = google_project.project.project_id
+  runtime_type     = "HYBRID"
+  depends_on       = [google_project_service.apigee]
+}
+
+resource "google_apigee_environment" "env" {
+  org_id       = google_apigee_organization.apigee_org.id
+  name         = "tf-test%{random_suffix}"
+  description  = "Apigee Environment"
+  display_name = "environment-1"
+}
+
+data "google_apigee_environments" "envs" {
+  org_id = google_apigee_organization.apigee_org.id
+
+  depends_on = [google_apigee_environment.env]
+}
+`, context)
+}
+
+func TestDataSourceGoogleApigeeEnvironments_flatten(t *testing.T) {
+	names := flattenDatasourceGoogleApigeeEnvironmentNames([]interface{}{"dev", "", "prod"})
+	if expected := []string{"dev", "prod"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected environment names %v, got %v", expected, names)
+	}
+
+	env := flattenDatasourceGoogleApigeeEnvironment("organizations/my-org", map[string]interface{}{
+		"name":           "dev",
+		"displayName":    "Development",
+		"state":          "ACTIVE",
+		"deploymentType": "PROXY",
+		"apiProxyType":   "PROGRAMMABLE",
+	})
+	if env["id"] != "organizations/my-org/environments/dev" {
+		t.Errorf("expected environment id %q, got %q", "organizations/my-org/environments/dev", env["id"])
+	}
+	if env["state"] != "ACTIVE" || env["deployment_type"] != "PROXY" || env["api_proxy_type"] != "PROGRAMMABLE" {
+		t.Errorf("unexpected flattened environment %v", env)
+	}
+}
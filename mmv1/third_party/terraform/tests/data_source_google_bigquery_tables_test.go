@@ -0,0 +1,76 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleBigQueryTables_basic(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleBigQueryTablesConfig(datasetID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.#", "2"),
+					resource.TestCheckResourceAttrSet("data.google_bigquery_tables.all", "tables.0.creation_time"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.0.table_id", "table"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.0.type", "TABLE"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.0.labels.env", "test"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.1.table_id", "view"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.all", "tables.1.type", "VIEW"),
+					resource.TestCheckResourceAttr("data.google_bigquery_tables.limited", "tables.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleBigQueryTablesConfig(datasetID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "table" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "table"
+
+  labels = {
+    env = "test"
+  }
+}
+
+resource "google_bigquery_table" "view" {
+  deletion_protection = false
+  dataset_id          = google_bigquery_dataset.test.dataset_id
+  table_id            = "view"
+
+  view {
+    query          = "SELECT 1 AS one"
+    use_legacy_sql = false
+  }
+}
+
+data "google_bigquery_tables" "all" {
+  dataset_id = google_bigquery_dataset.test.dataset_id
+
+  depends_on = [google_bigquery_table.table, google_bigquery_table.view]
+}
+
+data "google_bigquery_tables" "limited" {
+  dataset_id  = google_bigquery_dataset.test.dataset_id
+  max_results = 1
+
+  depends_on = [google_bigquery_table.table, google_bigquery_table.view]
+}
+`, datasetID)
+}
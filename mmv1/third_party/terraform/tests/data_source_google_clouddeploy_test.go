@@ -0,0 +1,117 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleClouddeployTargets_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"region":        getTestRegionFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleClouddeploy_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_clouddeploy_targets.prod", "targets.#", "1"),
+					resource.TestCheckResourceAttr("data.google_clouddeploy_targets.prod", "targets.0.target_id", "tf-test-prod-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_clouddeploy_targets.prod", "targets.0.require_approval", "true"),
+					resource.TestCheckResourceAttrSet("data.google_clouddeploy_targets.prod", "targets.0.gke_cluster"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleClouddeployDeliveryPipeline_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"region":        getTestRegionFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleClouddeploy_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					checkDataSourceStateMatchesResourceState("data.google_clouddeploy_delivery_pipeline.pipeline", "google_clouddeploy_delivery_pipeline.pipeline"),
+					// The pipeline has no releases yet
+					resource.TestCheckResourceAttr("data.google_clouddeploy_delivery_pipeline.pipeline", "latest_release.#", "0"),
+					resource.TestCheckResourceAttr("data.google_clouddeploy_delivery_pipeline.pipeline", "latest_rollout.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleClouddeploy_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_clouddeploy_target" "staging" {
+  location = "%{region}"
+  name     = "tf-test-staging-%{random_suffix}"
+
+  gke {
+    cluster = "projects/%{project}/locations/%{region}/clusters/staging"
+  }
+
+  labels = {
+    env = "staging"
+  }
+}
+
+resource "google_clouddeploy_target" "prod" {
+  location         = "%{region}"
+  name             = "tf-test-prod-%{random_suffix}"
+  require_approval = true
+
+  gke {
+    cluster = "projects/%{project}/locations/%{region}/clusters/prod"
+  }
+
+  labels = {
+    env = "prod-%{random_suffix}"
+  }
+}
+
+resource "google_clouddeploy_delivery_pipeline" "pipeline" {
+  location = "%{region}"
+  name     = "tf-test-pipeline-%{random_suffix}"
+
+  serial_pipeline {
+    stages {
+      target_id = google_clouddeploy_target.staging.name
+    }
+
+    stages {
+      target_id = google_clouddeploy_target.prod.name
+    }
+  }
+}
+
+data "google_clouddeploy_targets" "prod" {
+  location = "%{region}"
+  filter   = "labels.env = prod-%{random_suffix}"
+
+  depends_on = [google_clouddeploy_target.staging, google_clouddeploy_target.prod]
+}
+
+data "google_clouddeploy_delivery_pipeline" "pipeline" {
+  location = google_clouddeploy_delivery_pipeline.pipeline.location
+  name     = google_clouddeploy_delivery_pipeline.pipeline.name
+}
+`, context)
+}
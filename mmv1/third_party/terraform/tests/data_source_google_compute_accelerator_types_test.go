@@ -0,0 +1,65 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeAcceleratorTypes_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeAcceleratorTypes_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.zonal", "accelerator_types.0.zone", "us-central1-a"),
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.zonal", "accelerator_types.0.name", "nvidia-tesla-t4"),
+					resource.TestCheckResourceAttrSet("data.google_compute_accelerator_types.zonal", "accelerator_types.0.maximum_cards_per_instance"),
+					resource.TestCheckResourceAttrSet("data.google_compute_accelerator_types.all_zones", "accelerator_types.1.zone"),
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.all_zones", "accelerator_types.0.name", "nvidia-tesla-t4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeAcceleratorTypes_basic() string {
+	return `
+data "google_compute_accelerator_types" "zonal" {
+  zone   = "us-central1-a"
+  filter = "name = nvidia-tesla-t4"
+}
+
+data "google_compute_accelerator_types" "all_zones" {
+  filter = "name = nvidia-tesla-t4"
+}
+`
+}
+
+func TestFlattenComputeAcceleratorTypesAcceleratorType(t *testing.T) {
+	t.Parallel()
+
+	got := flattenComputeAcceleratorTypesAcceleratorType(map[string]interface{}{
+		"name":                    "nvidia-tesla-k80",
+		"zone":                    "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a",
+		"maximumCardsPerInstance": float64(8),
+		"deprecated": map[string]interface{}{
+			"state": "OBSOLETE",
+		},
+	})
+
+	if got["zone"] != "us-central1-a" {
+		t.Errorf("expected zone us-central1-a, got %v", got["zone"])
+	}
+	if got["maximum_cards_per_instance"] != 8 {
+		t.Errorf("expected maximum_cards_per_instance 8, got %v", got["maximum_cards_per_instance"])
+	}
+	deprecated := got["deprecated"].([]interface{})
+	if len(deprecated) != 1 || deprecated[0].(map[string]interface{})["state"] != "OBSOLETE" {
+		t.Errorf("expected deprecated state OBSOLETE, got %v", deprecated)
+	}
+}
@@ -0,0 +1,80 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeFirewallRules_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFirewallDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeFirewallRules_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.all", "rules.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.0.name", "tf-test-allow-ssh-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.0.priority", "900"),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.0.source_ranges.0", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.0.allow.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.google_compute_firewall_rules.ingress", "rules.0.allow.0.ports.0", "22"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeFirewallRules_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_firewall" "ssh" {
+  name          = "tf-test-allow-ssh-%{random_suffix}"
+  network       = google_compute_network.network.name
+  priority      = 900
+  source_ranges = ["0.0.0.0/0"]
+
+  allow {
+    protocol = "tcp"
+    ports    = ["22"]
+  }
+}
+
+resource "google_compute_firewall" "egress" {
+  name               = "tf-test-deny-egress-%{random_suffix}"
+  network            = google_compute_network.network.name
+  direction          = "EGRESS"
+  destination_ranges = ["0.0.0.0/0"]
+
+  deny {
+    protocol = "all"
+  }
+}
+
+data "google_compute_firewall_rules" "all" {
+  network = google_compute_network.network.self_link
+
+  depends_on = [google_compute_firewall.ssh, google_compute_firewall.egress]
+}
+
+data "google_compute_firewall_rules" "ingress" {
+  network = google_compute_network.network.name
+  filter  = "direction = INGRESS"
+
+  depends_on = [google_compute_firewall.ssh, google_compute_firewall.egress]
+}
+`, context)
+}
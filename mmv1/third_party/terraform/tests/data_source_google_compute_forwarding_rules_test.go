@@ -0,0 +1,56 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeForwardingRules_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeForwardingRules_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_forwarding_rules.rules", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_forwarding_rules.rules", "rules.0.name", "tf-test-rule-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_compute_forwarding_rules.rules", "rules.0.port_range", "80-80"),
+					resource.TestCheckResourceAttr("data.google_compute_forwarding_rules.rules", "rules.0.load_balancing_scheme", "EXTERNAL"),
+					resource.TestCheckResourceAttrPair("data.google_compute_forwarding_rules.rules", "rules.0.target", "google_compute_target_pool.pool", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeForwardingRules_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_target_pool" "pool" {
+  name   = "tf-test-pool-%{random_suffix}"
+  region = "us-central1"
+}
+
+resource "google_compute_forwarding_rule" "rule" {
+  name       = "tf-test-rule-%{random_suffix}"
+  region     = "us-central1"
+  port_range = "80"
+  target     = google_compute_target_pool.pool.self_link
+}
+
+data "google_compute_forwarding_rules" "rules" {
+  region = "us-central1"
+  filter = "name = tf-test-rule-%{random_suffix}"
+
+  depends_on = [google_compute_forwarding_rule.rule]
+}
+`, context)
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeInstanceGroupManagerInstances_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceGroupManagerDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInstanceGroupManagerInstances_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instance_group_manager_instances.instances", "managed_instances.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_instance_group_manager_instances.instances", "managed_instances.0.current_action", "NONE"),
+					resource.TestCheckResourceAttr("data.google_compute_instance_group_manager_instances.instances", "managed_instances.0.instance_status", "RUNNING"),
+					resource.TestCheckResourceAttr("data.google_compute_instance_group_manager_instances.instances", "managed_instances.0.version.0.name", "primary"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instance_group_manager_instances.instances", "managed_instances.0.version.0.instance_template", "google_compute_instance_template.igm", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeInstanceGroupManagerInstances_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "igm" {
+  name         = "tf-test-igm-%{random_suffix}"
+  machine_type = "e2-medium"
+
+  disk {
+    source_image = data.google_compute_image.my_image.self_link
+    auto_delete  = true
+    boot         = true
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_compute_instance_group_manager" "igm" {
+  name               = "tf-test-igm-%{random_suffix}"
+  base_instance_name = "tf-test-igm-%{random_suffix}"
+  zone               = "us-central1-a"
+  target_size        = 2
+  wait_for_instances = true
+
+  version {
+    instance_template = google_compute_instance_template.igm.self_link
+    name              = "primary"
+  }
+}
+
+data "google_compute_instance_group_manager_instances" "instances" {
+  instance_group_manager = google_compute_instance_group_manager.igm.self_link
+}
+`, context)
+}
+
+func TestFlattenComputeInstanceGroupManagerManagedInstance(t *testing.T) {
+	t.Parallel()
+
+	mi := map[string]interface{}{
+		"name":           "igm-abcd",
+		"instance":       "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instances/igm-abcd",
+		"id":             "1234",
+		"instanceStatus": "RUNNING",
+		"currentAction":  "VERIFYING",
+		"version": map[string]interface{}{
+			"instanceTemplate": "projects/p/global/instanceTemplates/t",
+			"name":             "canary",
+		},
+		"instanceHealth": []interface{}{
+			map[string]interface{}{
+				"healthCheck":         "projects/p/global/healthChecks/hc",
+				"detailedHealthState": "HEALTHY",
+			},
+		},
+		"preservedStateFromPolicy": map[string]interface{}{
+			"disks": map[string]interface{}{
+				"data-b": map[string]interface{}{"source": "disk-b", "mode": "READ_WRITE", "autoDelete": "NEVER"},
+				"data-a": map[string]interface{}{"source": "disk-a", "mode": "READ_ONLY", "autoDelete": "ON_PERMANENT_INSTANCE_DELETION"},
+			},
+		},
+		"lastAttempt": map[string]interface{}{
+			"errors": map[string]interface{}{
+				"errors": []interface{}{
+					map[string]interface{}{"code": "QUOTA_EXCEEDED", "message": "Quota exceeded"},
+				},
+			},
+		},
+	}
+
+	got := flattenComputeInstanceGroupManagerManagedInstance(mi)
+
+	if got["current_action"] != "VERIFYING" {
+		t.Errorf("expected current_action VERIFYING, got %v", got["current_action"])
+	}
+	expectedVersion := []interface{}{
+		map[string]interface{}{
+			"instance_template": "projects/p/global/instanceTemplates/t",
+			"name":              "canary",
+		},
+	}
+	if !reflect.DeepEqual(got["version"], expectedVersion) {
+		t.Errorf("expected version %v, got %v", expectedVersion, got["version"])
+	}
+	expectedHealth := []interface{}{
+		map[string]interface{}{
+			"health_check":          "projects/p/global/healthChecks/hc",
+			"detailed_health_state": "HEALTHY",
+		},
+	}
+	if !reflect.DeepEqual(got["instance_health"], expectedHealth) {
+		t.Errorf("expected instance_health %v, got %v", expectedHealth, got["instance_health"])
+	}
+	if got["preserved_state_from_config"] != nil && len(got["preserved_state_from_config"].([]interface{})) != 0 {
+		t.Errorf("expected no preserved_state_from_config, got %v", got["preserved_state_from_config"])
+	}
+	disks := got["preserved_state_from_policy"].([]interface{})[0].(map[string]interface{})["disk"].([]interface{})
+	if len(disks) != 2 || disks[0].(map[string]interface{})["device_name"] != "data-a" || disks[1].(map[string]interface{})["device_name"] != "data-b" {
+		t.Errorf("expected disks sorted by device name, got %v", disks)
+	}
+	expectedErrs := []interface{}{"Quota exceeded"}
+	if !reflect.DeepEqual(got["last_attempt_errors"], expectedErrs) {
+		t.Errorf("expected last_attempt_errors %v, got %v", expectedErrs, got["last_attempt_errors"])
+	}
+}
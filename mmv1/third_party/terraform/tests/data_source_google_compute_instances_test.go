@@ -0,0 +1,133 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeInstances_filter(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInstances_filter(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instances.fleet", "instances.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.fleet", "instances.0.zone", "us-central1-a"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.fleet", "instances.0.machine_type", "e2-micro"),
+					resource.TestCheckResourceAttr("data.google_compute_instances.fleet", "instances.0.labels.fleet", context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_compute_instances.fleet", "instances.1.zone", "us-east1-b"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instances.fleet", "instances.1.network_interface.0.network_ip", "google_compute_instance.east", "network_interface.0.network_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeInstances_filter(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance" "central" {
+  name         = "tf-test-central-%{random_suffix}"
+  machine_type = "e2-micro"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = data.google_compute_image.my_image.self_link
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  labels = {
+    fleet = "%{random_suffix}"
+  }
+}
+
+resource "google_compute_instance" "east" {
+  name         = "tf-test-east-%{random_suffix}"
+  machine_type = "e2-micro"
+  zone         = "us-east1-b"
+
+  boot_disk {
+    initialize_params {
+      image = data.google_compute_image.my_image.self_link
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  labels = {
+    fleet = "%{random_suffix}"
+  }
+}
+
+data "google_compute_instances" "fleet" {
+  filter = "labels.fleet = %{random_suffix}"
+
+  depends_on = [
+    google_compute_instance.central,
+    google_compute_instance.east,
+  ]
+}
+`, context)
+}
+
+func TestFlattenComputeInstancesInstance(t *testing.T) {
+	t.Parallel()
+
+	got := flattenComputeInstancesInstance(map[string]interface{}{
+		"name":        "vm-1",
+		"zone":        "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a",
+		"machineType": "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/machineTypes/n2-standard-2",
+		"status":      "RUNNING",
+		"networkInterfaces": []interface{}{
+			map[string]interface{}{
+				"name":      "nic0",
+				"networkIP": "10.0.0.2",
+				"accessConfigs": []interface{}{
+					map[string]interface{}{"natIP": "34.1.2.3"},
+				},
+			},
+			map[string]interface{}{
+				"name":      "nic1",
+				"networkIP": "10.1.0.2",
+			},
+		},
+	})
+
+	if got["zone"] != "us-central1-a" {
+		t.Errorf("expected zone us-central1-a, got %v", got["zone"])
+	}
+	if got["machine_type"] != "n2-standard-2" {
+		t.Errorf("expected machine_type n2-standard-2, got %v", got["machine_type"])
+	}
+	nics := got["network_interface"].([]interface{})
+	if len(nics) != 2 {
+		t.Fatalf("expected 2 network interfaces, got %d", len(nics))
+	}
+	if nics[0].(map[string]interface{})["nat_ip"] != "34.1.2.3" {
+		t.Errorf("expected nat_ip 34.1.2.3, got %v", nics[0].(map[string]interface{})["nat_ip"])
+	}
+	if nics[1].(map[string]interface{})["nat_ip"] != "" {
+		t.Errorf("expected empty nat_ip, got %v", nics[1].(map[string]interface{})["nat_ip"])
+	}
+}
@@ -0,0 +1,62 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeInterconnectLocations_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInterconnectLocationsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_locations.all", "locations.0.name"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_locations.all", "locations.0.continent"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_locations.all", "locations.0.self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_interconnect_locations.north_america", "locations.0.continent", "NORTH_AMERICA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleComputeInterconnectRemoteLocations_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInterconnectRemoteLocationsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_remote_locations.all", "remote_locations.0.name"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_remote_locations.all", "remote_locations.0.remote_service"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect_remote_locations.all", "remote_locations.0.self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeInterconnectLocationsConfig() string {
+	return `
+data "google_compute_interconnect_locations" "all" {}
+
+data "google_compute_interconnect_locations" "north_america" {
+  filter = "continent = NORTH_AMERICA"
+}
+`
+}
+
+func testAccDataSourceGoogleComputeInterconnectRemoteLocationsConfig() string {
+	return `
+data "google_compute_interconnect_remote_locations" "all" {}
+`
+}
@@ -0,0 +1,110 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeMachineTypes_filter(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeMachineTypes_filter(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_machine_types.e2", "zone", "us-central1-a"),
+					resource.TestCheckResourceAttrSet("data.google_compute_machine_types.e2", "machine_types.0.name"),
+					resource.TestCheckResourceAttr("data.google_compute_machine_types.e2", "machine_types.0.is_shared_cpu", "false"),
+					resource.TestCheckResourceAttrSet("data.google_compute_machine_types.e2", "supported_disk_types.0.name"),
+					resource.TestCheckResourceAttrSet("data.google_compute_machine_types.gpu", "machine_types.0.accelerators.0.guest_accelerator_count"),
+					resource.TestCheckResourceAttr("data.google_compute_machine_types.gpu", "machine_types.0.accelerators.0.guest_accelerator_type", "nvidia-tesla-a100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeMachineTypes_filter() string {
+	return `
+data "google_compute_machine_types" "e2" {
+  zone           = "us-central1-a"
+  filter         = "name = e2-standard-* AND isSharedCpu = false"
+  min_guest_cpus = 4
+  min_memory_mb  = 16384
+}
+
+data "google_compute_machine_types" "gpu" {
+  zone             = "us-central1-a"
+  accelerator_type = "nvidia-tesla-a100"
+}
+`
+}
+
+func TestFlattenComputeMachineTypesMachineType(t *testing.T) {
+	t.Parallel()
+
+	got := flattenComputeMachineTypesMachineType(map[string]interface{}{
+		"name":                         "a2-highgpu-1g",
+		"guestCpus":                    float64(12),
+		"memoryMb":                     float64(87040),
+		"maximumPersistentDisks":       float64(128),
+		"maximumPersistentDisksSizeGb": "263168",
+		"accelerators": []interface{}{
+			map[string]interface{}{
+				"guestAcceleratorType":  "nvidia-tesla-a100",
+				"guestAcceleratorCount": float64(1),
+			},
+		},
+		"deprecated": map[string]interface{}{
+			"state":       "DEPRECATED",
+			"replacement": "a3-highgpu-1g",
+		},
+	})
+
+	if got["guest_cpus"] != 12 {
+		t.Errorf("expected guest_cpus 12, got %v", got["guest_cpus"])
+	}
+	if got["maximum_persistent_disks_size_gb"] != 263168 {
+		t.Errorf("expected maximum_persistent_disks_size_gb 263168, got %v", got["maximum_persistent_disks_size_gb"])
+	}
+	if got["is_shared_cpu"] != false {
+		t.Errorf("expected is_shared_cpu false, got %v", got["is_shared_cpu"])
+	}
+	if !machineTypeHasAccelerator(got, "nvidia-tesla-a100") {
+		t.Errorf("expected machine type to have accelerator nvidia-tesla-a100")
+	}
+	if machineTypeHasAccelerator(got, "nvidia-l4") {
+		t.Errorf("expected machine type not to have accelerator nvidia-l4")
+	}
+	if len(got["bundled_local_ssds"].([]interface{})) != 0 {
+		t.Errorf("expected no bundled_local_ssds, got %v", got["bundled_local_ssds"])
+	}
+	deprecated := got["deprecated"].([]interface{})
+	if len(deprecated) != 1 || deprecated[0].(map[string]interface{})["replacement"] != "a3-highgpu-1g" {
+		t.Errorf("expected deprecated replacement a3-highgpu-1g, got %v", deprecated)
+	}
+}
+
+func TestFlattenComputeMachineTypesDiskType(t *testing.T) {
+	t.Parallel()
+
+	got := flattenComputeMachineTypesDiskType(map[string]interface{}{
+		"name":              "pd-balanced",
+		"defaultDiskSizeGb": "100",
+		"validDiskSize":     "10GB-65536GB",
+	})
+
+	if got["name"] != "pd-balanced" {
+		t.Errorf("expected name pd-balanced, got %v", got["name"])
+	}
+	if got["default_disk_size_gb"] != 100 {
+		t.Errorf("expected default_disk_size_gb 100, got %v", got["default_disk_size_gb"])
+	}
+	if got["valid_disk_size"] != "10GB-65536GB" {
+		t.Errorf("expected valid_disk_size 10GB-65536GB, got %v", got["valid_disk_size"])
+	}
+}
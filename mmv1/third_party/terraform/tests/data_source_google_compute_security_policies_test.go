@@ -0,0 +1,77 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceComputeSecurityPolicies_basic(t *testing.T) {
+	t.Parallel()
+
+	policyName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeSecurityPoliciesConfig(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.all", "security_policies.#", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.one", "security_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.one", "security_policies.0.name", policyName+"-0"),
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.one", "security_policies.0.type", "CLOUD_ARMOR"),
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.one", "security_policies.0.rule_count", "2"),
+					resource.TestCheckResourceAttr("data.google_compute_security_policies.one", "security_policies.0.region", ""),
+					resource.TestCheckResourceAttrSet("data.google_compute_security_policies.one", "security_policies.0.self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeSecurityPoliciesConfig(policyName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_security_policy" "policy" {
+  count       = 2
+  name        = "%s-${count.index}"
+  description = "basic security policy"
+  type        = "CLOUD_ARMOR"
+
+  rule {
+    action   = "deny(403)"
+    priority = "1000"
+    match {
+      versioned_expr = "SRC_IPS_V1"
+      config {
+        src_ip_ranges = ["9.9.9.0/24"]
+      }
+    }
+  }
+
+  rule {
+    action   = "allow"
+    priority = "2147483647"
+    match {
+      versioned_expr = "SRC_IPS_V1"
+      config {
+        src_ip_ranges = ["*"]
+      }
+    }
+    description = "default rule"
+  }
+}
+
+data "google_compute_security_policies" "all" {
+  filter     = "name:%s-*"
+  depends_on = [google_compute_security_policy.policy]
+}
+
+data "google_compute_security_policies" "one" {
+  filter     = "name=%s-0"
+  depends_on = [google_compute_security_policy.policy]
+}
+`, policyName, policyName, policyName)
+}
@@ -0,0 +1,49 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeTargetPools_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeTargetPoolDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeTargetPools_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_target_pools.pools", "target_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_target_pools.pools", "target_pools.0.name", "tf-test-pool-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_compute_target_pools.pools", "target_pools.0.session_affinity", "CLIENT_IP"),
+					resource.TestCheckResourceAttrPair("data.google_compute_target_pools.pools", "target_pools.0.self_link", "google_compute_target_pool.pool", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeTargetPools_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_target_pool" "pool" {
+  name             = "tf-test-pool-%{random_suffix}"
+  region           = "us-central1"
+  session_affinity = "CLIENT_IP"
+}
+
+data "google_compute_target_pools" "pools" {
+  region = "us-central1"
+  filter = "name = tf-test-pool-%{random_suffix}"
+
+  depends_on = [google_compute_target_pool.pool]
+}
+`, context)
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeUsableSubnetworks_region(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSubnetworkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeUsableSubnetworks_region(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_usable_subnetworks.usable", "subnetworks.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_usable_subnetworks.usable", "subnetworks.0.name", "tf-test-usable-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_compute_usable_subnetworks.usable", "subnetworks.0.region", "us-east1"),
+					resource.TestCheckResourceAttr("data.google_compute_usable_subnetworks.usable", "subnetworks.0.ip_cidr_range", "10.2.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleComputeUsableSubnetworks_limit(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSubnetworkDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeUsableSubnetworks_limit(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_usable_subnetworks.usable", "subnetworks.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeUsableSubnetworks_limit(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "net" {
+  name                    = "tf-test-usable-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "central" {
+  name          = "tf-test-usable-central-%{random_suffix}"
+  ip_cidr_range = "10.1.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.net.id
+}
+
+resource "google_compute_subnetwork" "east" {
+  name          = "tf-test-usable-east-%{random_suffix}"
+  ip_cidr_range = "10.2.0.0/16"
+  region        = "us-east1"
+  network       = google_compute_network.net.id
+}
+
+data "google_compute_usable_subnetworks" "usable" {
+  filter      = "subnetwork eq .*tf-test-usable-.*%{random_suffix}"
+  max_results = 1
+  limit       = 1
+
+  depends_on = [
+    google_compute_subnetwork.central,
+    google_compute_subnetwork.east,
+  ]
+}
+`, context)
+}
+
+func testAccDataSourceGoogleComputeUsableSubnetworks_region(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "net" {
+  name                    = "tf-test-usable-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "central" {
+  name          = "tf-test-usable-central-%{random_suffix}"
+  ip_cidr_range = "10.1.0.0/16"
+  region        = "us-central1"
+  network       = google_compute_network.net.id
+}
+
+resource "google_compute_subnetwork" "east" {
+  name          = "tf-test-usable-%{random_suffix}"
+  ip_cidr_range = "10.2.0.0/16"
+  region        = "us-east1"
+  network       = google_compute_network.net.id
+}
+
+data "google_compute_usable_subnetworks" "usable" {
+  region = "us-east1"
+  filter = "subnetwork eq .*tf-test-usable-.*%{random_suffix}"
+
+  depends_on = [
+    google_compute_subnetwork.central,
+    google_compute_subnetwork.east,
+  ]
+}
+`, context)
+}
+
+func TestFlattenComputeUsableSubnetwork(t *testing.T) {
+	t.Parallel()
+
+	got := flattenComputeUsableSubnetwork(map[string]interface{}{
+		"subnetwork":  "https://www.googleapis.com/compute/v1/projects/my-project/regions/europe-west1/subnetworks/my-subnet",
+		"network":     "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-net",
+		"ipCidrRange": "10.0.0.0/24",
+		"secondaryIpRanges": []interface{}{
+			map[string]interface{}{"rangeName": "pods", "ipCidrRange": "10.4.0.0/14"},
+		},
+	})
+
+	if got["name"] != "my-subnet" {
+		t.Errorf("expected name my-subnet, got %v", got["name"])
+	}
+	if got["region"] != "europe-west1" {
+		t.Errorf("expected region europe-west1, got %v", got["region"])
+	}
+	ranges := got["secondary_ip_ranges"].([]interface{})
+	if len(ranges) != 1 || ranges[0].(map[string]interface{})["range_name"] != "pods" {
+		t.Errorf("expected one secondary range named pods, got %v", ranges)
+	}
+}
@@ -0,0 +1,64 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleEssentialContacts_basic(t *testing.T) {
+	t.Parallel()
+
+	email := fmt.Sprintf("tf-test-security%s@example.com", randString(t, 10))
+	context := map[string]interface{}{
+		"email": email,
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssentialContactsContactDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleEssentialContacts_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_essential_contacts.all", "contacts.*", map[string]string{
+						"email":        email,
+						"language_tag": "en-GB",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_essential_contacts.security", "contacts.*", map[string]string{
+						"email": email,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleEssentialContacts_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project" "project" {
+}
+
+resource "google_essential_contacts_contact" "security" {
+  parent                              = data.google_project.project.id
+  email                               = "%{email}"
+  language_tag                        = "en-GB"
+  notification_category_subscriptions = ["SECURITY"]
+}
+
+data "google_essential_contacts" "all" {
+  parent = data.google_project.project.id
+
+  depends_on = [google_essential_contacts_contact.security]
+}
+
+data "google_essential_contacts" "security" {
+  parent                  = data.google_project.project.id
+  notification_categories = ["SECURITY"]
+
+  depends_on = [google_essential_contacts_contact.security]
+}
+`, context)
+}
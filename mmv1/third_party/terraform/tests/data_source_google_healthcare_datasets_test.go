@@ -0,0 +1,72 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceGoogleHealthcareDatasets_basic(t *testing.T) {
+	t.Parallel()
+
+	datasetName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleHealthcareDatasetsConfig(datasetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthcareDatasetListed("data.google_healthcare_datasets.all", datasetName, "America/New_York"),
+				),
+			},
+		},
+	})
+}
+
+// The project may contain other datasets in the same location, so only check
+// that the one created by the test is part of the list.
+func testAccCheckHealthcareDatasetListed(n, name, timeZone string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(ds.Primary.Attributes["datasets.#"])
+		if err != nil {
+			return fmt.Errorf("Error reading datasets count: %s", err)
+		}
+
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("datasets.%d.", i)
+			if ds.Primary.Attributes[prefix+"name"] != name {
+				continue
+			}
+			if got := ds.Primary.Attributes[prefix+"time_zone"]; got != timeZone {
+				return fmt.Errorf("Expected time_zone %q for dataset %q, got %q", timeZone, name, got)
+			}
+			return nil
+		}
+		return fmt.Errorf("Dataset %q not found in %s", name, n)
+	}
+}
+
+func testAccDataSourceGoogleHealthcareDatasetsConfig(datasetName string) string {
+	return fmt.Sprintf(`
+resource "google_healthcare_dataset" "dataset" {
+  name      = "%s"
+  location  = "us-central1"
+  time_zone = "America/New_York"
+}
+
+data "google_healthcare_datasets" "all" {
+  location   = "us-central1"
+  depends_on = [google_healthcare_dataset.dataset]
+}
+`, datasetName)
+}
@@ -0,0 +1,100 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleHealthcareStores_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleHealthcareStoresConfig(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_healthcare_fhir_stores.all", "fhir_stores.#", "1"),
+					resource.TestCheckResourceAttr("data.google_healthcare_fhir_stores.all", "fhir_stores.0.name", fmt.Sprintf("tf-test-fhir-%s", context["random_suffix"])),
+					resource.TestCheckResourceAttr("data.google_healthcare_fhir_stores.all", "fhir_stores.0.version", "R4"),
+					resource.TestCheckResourceAttr("data.google_healthcare_fhir_stores.all", "fhir_stores.0.labels.label1", "labelvalue1"),
+					resource.TestCheckResourceAttrPair("data.google_healthcare_fhir_stores.all", "fhir_stores.0.notification_config.0.pubsub_topic", "google_pubsub_topic.topic", "id"),
+					resource.TestCheckResourceAttr("data.google_healthcare_dicom_stores.all", "dicom_stores.#", "1"),
+					resource.TestCheckResourceAttr("data.google_healthcare_dicom_stores.all", "dicom_stores.0.name", fmt.Sprintf("tf-test-dicom-%s", context["random_suffix"])),
+					resource.TestCheckResourceAttrPair("data.google_healthcare_dicom_stores.all", "dicom_stores.0.notification_config.0.pubsub_topic", "google_pubsub_topic.topic", "id"),
+					resource.TestCheckResourceAttr("data.google_healthcare_hl7_v2_stores.all", "hl7_v2_stores.#", "1"),
+					resource.TestCheckResourceAttr("data.google_healthcare_hl7_v2_stores.all", "hl7_v2_stores.0.name", fmt.Sprintf("tf-test-hl7-%s", context["random_suffix"])),
+					resource.TestCheckResourceAttr("data.google_healthcare_hl7_v2_stores.all", "hl7_v2_stores.0.notification_configs.0.filter", "messageType = \"ADT\""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleHealthcareStoresConfig(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-topic-%{random_suffix}"
+}
+
+resource "google_healthcare_dataset" "dataset" {
+  name     = "tf-test-dataset-%{random_suffix}"
+  location = "us-central1"
+}
+
+resource "google_healthcare_fhir_store" "store" {
+  name    = "tf-test-fhir-%{random_suffix}"
+  dataset = google_healthcare_dataset.dataset.id
+  version = "R4"
+
+  notification_config {
+    pubsub_topic = google_pubsub_topic.topic.id
+  }
+
+  labels = {
+    label1 = "labelvalue1"
+  }
+}
+
+resource "google_healthcare_dicom_store" "store" {
+  name    = "tf-test-dicom-%{random_suffix}"
+  dataset = google_healthcare_dataset.dataset.id
+
+  notification_config {
+    pubsub_topic = google_pubsub_topic.topic.id
+  }
+}
+
+resource "google_healthcare_hl7_v2_store" "store" {
+  name    = "tf-test-hl7-%{random_suffix}"
+  dataset = google_healthcare_dataset.dataset.id
+
+  notification_configs {
+    pubsub_topic = google_pubsub_topic.topic.id
+    filter       = "messageType = \"ADT\""
+  }
+}
+
+data "google_healthcare_fhir_stores" "all" {
+  dataset    = google_healthcare_dataset.dataset.id
+  depends_on = [google_healthcare_fhir_store.store]
+}
+
+data "google_healthcare_dicom_stores" "all" {
+  dataset    = google_healthcare_dataset.dataset.id
+  depends_on = [google_healthcare_dicom_store.store]
+}
+
+data "google_healthcare_hl7_v2_stores" "all" {
+  dataset    = google_healthcare_dataset.dataset.id
+  depends_on = [google_healthcare_hl7_v2_store.store]
+}
+`, context)
+}
@@ -0,0 +1,44 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleImportableResources_storageBucket(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-import-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleImportableResourcesConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_importable_resources.buckets", fmt.Sprintf("ids.%s", bucketName), bucketName),
+					resource.TestCheckResourceAttrSet("data.google_importable_resources.buckets", "import_blocks"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleImportableResourcesConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+data "google_importable_resources" "buckets" {
+  resource_type = "google_storage_bucket"
+
+  depends_on = [google_storage_bucket.bucket]
+}
+`, bucketName)
+}
@@ -0,0 +1,84 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleOSConfigInventories_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleOSConfigInventories_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_os_config_inventories.all", "inventories.#"),
+					resource.TestCheckResourceAttr("data.google_os_config_inventories.all", "zone", "us-central1-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleOSConfigInventories_basic() string {
+	return `
+data "google_os_config_inventories" "all" {
+  zone = "us-central1-a"
+  view = "BASIC"
+}
+`
+}
+
+func TestFlattenOSConfigInventory(t *testing.T) {
+	t.Parallel()
+
+	inventory := flattenOSConfigInventory(map[string]interface{}{
+		"name":       "projects/123/locations/us-central1-a/instances/4567/inventory",
+		"updateTime": "2023-01-01T00:00:00Z",
+		"osInfo": map[string]interface{}{
+			"hostname":  "my-vm",
+			"shortName": "debian",
+			"version":   "11",
+		},
+		"items": map[string]interface{}{
+			"installed-1": map[string]interface{}{
+				"type":             "INSTALLED_PACKAGE",
+				"installedPackage": map[string]interface{}{"aptPackage": map[string]interface{}{"packageName": "bash"}},
+			},
+			"installed-2": map[string]interface{}{
+				"type":             "INSTALLED_PACKAGE",
+				"installedPackage": map[string]interface{}{"aptPackage": map[string]interface{}{"packageName": "openssl"}},
+			},
+			"available-1": map[string]interface{}{
+				"type":             "AVAILABLE_PACKAGE",
+				"availablePackage": map[string]interface{}{"aptPackage": map[string]interface{}{"packageName": "openssl"}},
+			},
+			"available-2": map[string]interface{}{
+				"type":             "AVAILABLE_PACKAGE",
+				"availablePackage": map[string]interface{}{"qfePackage": map[string]interface{}{"hotFixId": "KB5000001"}},
+			},
+		},
+	})
+
+	if inventory["instance_id"] != "4567" {
+		t.Errorf("expected instance_id 4567, got %v", inventory["instance_id"])
+	}
+	if inventory["installed_package_count"] != 2 {
+		t.Errorf("expected 2 installed packages, got %v", inventory["installed_package_count"])
+	}
+	if inventory["available_package_count"] != 2 {
+		t.Errorf("expected 2 available packages, got %v", inventory["available_package_count"])
+	}
+	if want := []string{"KB5000001", "openssl"}; !reflect.DeepEqual(inventory["available_packages"], want) {
+		t.Errorf("expected available packages %v, got %v", want, inventory["available_packages"])
+	}
+	osInfo := inventory["os_info"].([]interface{})[0].(map[string]interface{})
+	if osInfo["hostname"] != "my-vm" || osInfo["short_name"] != "debian" || osInfo["kernel_version"] != "" {
+		t.Errorf("unexpected os_info %v", osInfo)
+	}
+}
@@ -0,0 +1,105 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGooglePolicySimulatorReplay_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project": getTestProjectFromEnv(),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGooglePolicySimulatorReplay_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_policy_simulator_replay.replay", "name"),
+					resource.TestCheckResourceAttr("data.google_policy_simulator_replay.replay", "state", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet("data.google_policy_simulator_replay.replay", "log_count"),
+					resource.TestCheckResourceAttrSet("data.google_policy_simulator_replay.replay", "access_removed"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicySimulatorReplayResultFlatten(t *testing.T) {
+	cases := map[string]struct {
+		Result         map[string]interface{}
+		ExpectedChange string
+	}{
+		"no change": {
+			Result: map[string]interface{}{
+				"diff": map[string]interface{}{
+					"accessDiff": map[string]interface{}{"accessChange": "NO_CHANGE"},
+				},
+			},
+		},
+		"error result": {
+			Result: map[string]interface{}{
+				"error": map[string]interface{}{"message": "failed"},
+			},
+		},
+		"access revoked": {
+			Result: map[string]interface{}{
+				"accessTuple": map[string]interface{}{
+					"principal":        "user:alice@example.com",
+					"fullResourceName": "//cloudresourcemanager.googleapis.com/projects/my-project",
+					"permission":       "resourcemanager.projects.get",
+				},
+				"diff": map[string]interface{}{
+					"accessDiff": map[string]interface{}{
+						"accessChange": "ACCESS_REVOKED",
+						"baseline":     map[string]interface{}{"accessState": "GRANTED"},
+						"simulated":    map[string]interface{}{"accessState": "NOT_GRANTED"},
+					},
+				},
+			},
+			ExpectedChange: "ACCESS_REVOKED",
+		},
+	}
+
+	for tn, tc := range cases {
+		delta := flattenPolicySimulatorReplayResult(tc.Result)
+		if tc.ExpectedChange == "" {
+			if delta != nil {
+				t.Errorf("%s: expected no access delta, got %v", tn, delta)
+			}
+			continue
+		}
+		if delta == nil {
+			t.Errorf("%s: expected an access delta, got none", tn)
+			continue
+		}
+		if delta["access_change"] != tc.ExpectedChange {
+			t.Errorf("%s: expected access_change %q, got %q", tn, tc.ExpectedChange, delta["access_change"])
+		}
+		if delta["simulated_access_state"] != "NOT_GRANTED" {
+			t.Errorf("%s: expected simulated_access_state NOT_GRANTED, got %q", tn, delta["simulated_access_state"])
+		}
+	}
+}
+
+func testAccDataSourceGooglePolicySimulatorReplay_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project_iam_policy" "current" {
+  project = "%{project}"
+}
+
+data "google_policy_simulator_replay" "replay" {
+  project = "%{project}"
+
+  policy_overlay {
+    resource    = "//cloudresourcemanager.googleapis.com/projects/%{project}"
+    policy_data = data.google_project_iam_policy.current.policy_data
+  }
+}
+`, context)
+}
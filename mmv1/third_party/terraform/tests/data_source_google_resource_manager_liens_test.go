@@ -0,0 +1,86 @@
+package google
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleResourceManagerLiens_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_id":    "tf-test-" + randString(t, 10),
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckResourceManagerLienDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleResourceManagerLiens_basic(context, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_resource_manager_liens.liens", "liens.#", "1"),
+					resource.TestCheckResourceAttr("data.google_resource_manager_liens.liens", "liens.0.origin", "machine-readable-explanation"),
+					resource.TestCheckResourceAttr("data.google_resource_manager_liens.liens", "liens.0.restrictions.0", "resourcemanager.projects.delete"),
+					resource.TestCheckResourceAttrPair("data.google_resource_manager_liens.liens", "liens.0.name", "google_resource_manager_lien.lien", "name"),
+				),
+			},
+			{
+				Config:      testAccDataSourceGoogleResourceManagerLiens_basic(context, true),
+				ExpectError: regexp.MustCompile("lien\\(s\\) that must be removed first"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleResourceManagerLiens_basic(context map[string]interface{}, failIfPresent bool) string {
+	context["fail_if_present"] = failIfPresent
+	return Nprintf(`
+resource "google_project" "project" {
+  project_id = "%{project_id}"
+  name       = "%{project_id}"
+  org_id     = "%{org_id}"
+}
+
+resource "google_resource_manager_lien" "lien" {
+  parent       = "projects/${google_project.project.number}"
+  restrictions = ["resourcemanager.projects.delete"]
+  origin       = "machine-readable-explanation"
+  reason       = "This project is very important"
+}
+
+data "google_resource_manager_liens" "liens" {
+  project         = google_project.project.project_id
+  restriction     = "resourcemanager.projects.delete"
+  fail_if_present = %{fail_if_present}
+
+  depends_on = [google_resource_manager_lien.lien]
+}
+`, context)
+}
+
+func TestFlattenResourceManagerLien(t *testing.T) {
+	t.Parallel()
+
+	lien := flattenResourceManagerLien(map[string]interface{}{
+		"name":         "liens/p1234-abcd",
+		"origin":       "compute.googleapis.com",
+		"reason":       "Shared VPC host project",
+		"restrictions": []interface{}{"resourcemanager.projects.delete"},
+	})
+
+	if lien["name"] != "p1234-abcd" {
+		t.Errorf("expected name p1234-abcd, got %v", lien["name"])
+	}
+	if !lienHasRestriction(lien, "resourcemanager.projects.delete") {
+		t.Errorf("expected lien to block resourcemanager.projects.delete")
+	}
+	if lienHasRestriction(lien, "resourcemanager.projects.update") {
+		t.Errorf("expected lien not to block resourcemanager.projects.update")
+	}
+}
@@ -0,0 +1,69 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleSccV2Findings_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id": getTestOrgFromEnv(t),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleSccV2Findings_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_scc_v2_findings.findings", "findings.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleSccV2Findings_basic(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_scc_v2_findings" "findings" {
+  organization = "%{org_id}"
+  severity     = "CRITICAL"
+  state        = "ACTIVE"
+  max_results  = 10
+}
+`, context)
+}
+
+func TestSccV2FindingsFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Filter, Category, Severity, State string
+		Expected                          string
+	}{
+		"empty": {
+			Expected: "",
+		},
+		"filter only": {
+			Filter:   `resource.type="google.cloud.storage.Bucket"`,
+			Expected: `(resource.type="google.cloud.storage.Bucket")`,
+		},
+		"all fields": {
+			Filter:   `mute="UNMUTED" OR mute="UNDEFINED"`,
+			Category: "PUBLIC_BUCKET_ACL",
+			Severity: "HIGH",
+			State:    "ACTIVE",
+			Expected: `(mute="UNMUTED" OR mute="UNDEFINED") AND category="PUBLIC_BUCKET_ACL" AND severity="HIGH" AND state="ACTIVE"`,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := sccV2FindingsFilter(tc.Filter, tc.Category, tc.Severity, tc.State); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
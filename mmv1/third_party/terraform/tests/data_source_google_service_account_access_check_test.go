@@ -0,0 +1,41 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGoogleServiceAccountAccessCheck_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_service_account_access_check.default"
+	serviceAccount := getTestServiceAccountFromEnv(t)
+	targetServiceAccountEmail := BootstrapServiceAccount(t, getTestProjectFromEnv(), serviceAccount)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleServiceAccountAccessCheck_datasource(targetServiceAccountEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "projects/-/serviceAccounts/"+targetServiceAccountEmail),
+					resource.TestCheckResourceAttr(resourceName, "can_create_access_token", "true"),
+					resource.TestCheckResourceAttr(resourceName, "can_create_id_token", "true"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "granted_permissions.*", "iam.serviceAccounts.getAccessToken"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleServiceAccountAccessCheck_datasource(targetServiceAccountEmail string) string {
+	return fmt.Sprintf(`
+data "google_service_account_access_check" "default" {
+  account_id  = "%s"
+  permissions = ["iam.serviceAccounts.actAs"]
+}
+`, targetServiceAccountEmail)
+}
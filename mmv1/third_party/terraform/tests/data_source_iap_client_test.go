@@ -35,6 +35,38 @@ func TestAccIapClient_Datasource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIapClient_Datasource_displayName(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"org_domain":    getTestOrgDomainFromEnv(t),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapClientDatasourceConfig_displayName(context),
+				Check: resource.ComposeTestCheckFunc(
+					checkDataSourceStateMatchesResourceStateWithIgnores(
+						"data.google_iap_client.project_client",
+						"google_iap_client.project_client",
+						map[string]struct{}{
+							"brand": {},
+						},
+					),
+					resource.TestCheckResourceAttr("data.google_iap_brands.brands", "brands.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_iap_brands.brands", "brands.0.name", "google_iap_brand.project_brand", "name"),
+					resource.TestCheckResourceAttr("data.google_iap_brands.brands", "brands.0.org_internal_only", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIapClientDatasourceConfig(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_project" "project" {
@@ -65,3 +97,45 @@ data "google_iap_client" "project_client" {
 }
 `, context)
 }
+
+func testAccIapClientDatasourceConfig_displayName(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "project" {
+  project_id = "tf-test%{random_suffix}"
+  name       = "tf-test%{random_suffix}"
+  org_id     = "%{org_id}"
+}
+
+resource "google_project_service" "project_service" {
+  project = google_project.project.project_id
+  service = "iap.googleapis.com"
+}
+
+resource "google_iap_brand" "project_brand" {
+  support_email     = "support@%{org_domain}"
+  application_title = "Cloud IAP protected Application"
+  project           = google_project_service.project_service.project
+}
+
+resource "google_iap_client" "other_client" {
+  display_name = "Other Client"
+  brand        = google_iap_brand.project_brand.name
+}
+
+resource "google_iap_client" "project_client" {
+  display_name = "Test Client"
+  brand        = google_iap_brand.project_brand.name
+}
+
+data "google_iap_client" "project_client" {
+  brand        = google_iap_client.project_client.brand
+  display_name = google_iap_client.project_client.display_name
+
+  depends_on = [google_iap_client.other_client]
+}
+
+data "google_iap_brands" "brands" {
+  project = google_iap_brand.project_brand.project
+}
+`, context)
+}
@@ -0,0 +1,54 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOSConfigPatchDeployments_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOSConfigPatchDeploymentDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOSConfigPatchDeployments_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("data.google_os_config_patch_deployments.all", "patch_deployments.*.name", "google_os_config_patch_deployment.patch", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_os_config_patch_deployments.all", "patch_deployments.*", map[string]string{
+						"description":           "Listed by a data source.",
+						"instance_filter.0.all": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOSConfigPatchDeployments_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_os_config_patch_deployment" "patch" {
+  patch_deployment_id = "tf-test-patch-deploy%{random_suffix}"
+  description         = "Listed by a data source."
+
+  instance_filter {
+    all = true
+  }
+
+  one_time_schedule {
+    execute_time = "2999-10-10T10:10:10.045123456Z"
+  }
+}
+
+data "google_os_config_patch_deployments" "all" {
+  depends_on = [google_os_config_patch_deployment.patch]
+}
+`, context)
+}
@@ -0,0 +1,55 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecuresourcemanagerRepositories_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecuresourcemanagerRepositoryDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecuresourcemanagerRepositories_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_securesourcemanager_repositories.all", "repositories.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_securesourcemanager_repositories.all", "repositories.0.name", "google_securesourcemanager_repository.default", "name"),
+					resource.TestCheckResourceAttrPair("data.google_securesourcemanager_repositories.all", "repositories.0.instance", "google_securesourcemanager_repository.default", "instance"),
+					resource.TestCheckResourceAttr("data.google_securesourcemanager_repositories.all", "repositories.0.description", "Listed by a data source."),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecuresourcemanagerRepositories_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_securesourcemanager_instance" "instance" {
+  location    = "us-central1"
+  instance_id = "tf-test-my-instance%{random_suffix}"
+}
+
+resource "google_securesourcemanager_repository" "default" {
+  location      = "us-central1"
+  repository_id = "tf-test-my-repository%{random_suffix}"
+  instance      = google_securesourcemanager_instance.instance.name
+  description   = "Listed by a data source."
+}
+
+data "google_securesourcemanager_repositories" "all" {
+  location = "us-central1"
+  filter   = "name:tf-test-my-repository%{random_suffix}"
+
+  depends_on = [google_securesourcemanager_repository.default]
+}
+`, context)
+}
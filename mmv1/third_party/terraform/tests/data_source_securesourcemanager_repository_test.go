@@ -0,0 +1,75 @@
+package google
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecuresourcemanagerRepository_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecuresourcemanagerRepositoryDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecuresourcemanagerRepository_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					checkDataSourceStateMatchesResourceState("data.google_securesourcemanager_repository.default", "google_securesourcemanager_repository.default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSecuresourcemanagerRepository_notFound(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Nprintf(`
+data "google_securesourcemanager_repository" "default" {
+  location      = "us-central1"
+  repository_id = "tf-test-missing%{random_suffix}"
+}
+`, context),
+				ExpectError: regexp.MustCompile("Repository .* not found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecuresourcemanagerRepository_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_securesourcemanager_instance" "instance" {
+  location    = "us-central1"
+  instance_id = "tf-test-my-instance%{random_suffix}"
+}
+
+resource "google_securesourcemanager_repository" "default" {
+  location      = "us-central1"
+  repository_id = "tf-test-my-repository%{random_suffix}"
+  instance      = google_securesourcemanager_instance.instance.name
+  description   = "Read by a data source."
+}
+
+data "google_securesourcemanager_repository" "default" {
+  location      = google_securesourcemanager_repository.default.location
+  repository_id = google_securesourcemanager_repository.default.repository_id
+}
+`, context)
+}
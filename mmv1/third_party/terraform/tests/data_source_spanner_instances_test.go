@@ -0,0 +1,76 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSpannerInstancesAndDatabases_basic(t *testing.T) {
+	// Randomness from spanner instance
+	skipIfVcr(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSpannerInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSpannerInstancesAndDatabasesBasic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_spanner_instances.labeled", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_spanner_instances.labeled", "instances.0.name", "google_spanner_instance.instance", "name"),
+					resource.TestCheckResourceAttr("data.google_spanner_instances.labeled", "instances.0.config", "regional-us-central1"),
+					resource.TestCheckResourceAttr("data.google_spanner_instances.labeled", "instances.0.state", "READY"),
+					resource.TestCheckResourceAttr("data.google_spanner_databases.all", "databases.#", "2"),
+					resource.TestCheckResourceAttrSet("data.google_spanner_databases.all", "databases.0.create_time"),
+					resource.TestCheckResourceAttr("data.google_spanner_databases.all", "databases.0.state", "READY"),
+					resource.TestCheckResourceAttr("data.google_spanner_databases.all", "databases.0.encryption_info.0.encryption_type", "GOOGLE_DEFAULT_ENCRYPTION"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSpannerInstancesAndDatabasesBasic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_spanner_instance" "instance" {
+	config       = "regional-us-central1"
+	display_name = "tf-test-%{random_suffix}"
+	num_nodes    = 1
+	labels = {
+		"tf-test" = "%{random_suffix}"
+	}
+}
+
+resource "google_spanner_database" "google_standard_sql" {
+	instance            = google_spanner_instance.instance.name
+	name                = "tf-test-gsql-%{random_suffix}"
+	deletion_protection = false
+}
+
+resource "google_spanner_database" "postgresql" {
+	instance            = google_spanner_instance.instance.name
+	name                = "tf-test-pg-%{random_suffix}"
+	database_dialect    = "POSTGRESQL"
+	deletion_protection = false
+}
+
+data "google_spanner_instances" "labeled" {
+	filter = "labels.tf-test:%{random_suffix}"
+
+	depends_on = [google_spanner_instance.instance]
+}
+
+data "google_spanner_databases" "all" {
+	instance = google_spanner_instance.instance.name
+
+	depends_on = [google_spanner_database.google_standard_sql, google_spanner_database.postgresql]
+}
+`, context)
+}
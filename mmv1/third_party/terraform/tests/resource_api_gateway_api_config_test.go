@@ -1,6 +1,4 @@
-<% autogen_exception -%>
 package google
-<% unless version == 'ga' -%>
 
 import (
 	"testing"
@@ -16,8 +14,8 @@ func TestAccApiGatewayApiConfig_apigatewayApiConfigBasicExampleUpdated(t *testin
 	}
 
 	vcrTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProvidersOiCS,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckApiGatewayApiConfigDestroyProducer(t),
 		Steps: []resource.TestStep{
 			{
@@ -40,8 +38,8 @@ func TestAccApiGatewayApiConfig_generatedPrefix(t *testing.T) {
 	}
 
 	vcrTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProvidersOiCS,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckApiGatewayApiConfigDestroyProducer(t),
 		Steps: []resource.TestStep{
 			{
@@ -54,12 +52,10 @@ func TestAccApiGatewayApiConfig_generatedPrefix(t *testing.T) {
 func testAccApiGatewayApiConfig_apigatewayApiConfigBasicExampleUpdated(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_api_gateway_api" "api_cfg" {
-  provider = google-beta
   api_id = "tf-test-api-cfg%{random_suffix}"
 }
 
 resource "google_api_gateway_api_config" "api_cfg" {
-  provider = google-beta
   api = google_api_gateway_api.api_cfg.api_id
   api_config_id = "tf-test-api-cfg%{random_suffix}"
   display_name = "MM Dev API Config"
@@ -80,12 +76,10 @@ resource "google_api_gateway_api_config" "api_cfg" {
 func testAccApiGatewayApiConfig_generatedPrefix(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_api_gateway_api" "api_cfg" {
-  provider = google-beta
   api_id = "tf-test-api-cfg%{random_suffix}"
 }
 
 resource "google_api_gateway_api_config" "api_cfg" {
-  provider = google-beta
   api = google_api_gateway_api.api_cfg.api_id
   api_config_id_prefix = "tf-test-"
   display_name = "MM Dev API Config"
@@ -102,5 +96,3 @@ resource "google_api_gateway_api_config" "api_cfg" {
 }
 `, context)
 }
-
-<% end -%>
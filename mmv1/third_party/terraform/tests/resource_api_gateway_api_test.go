@@ -1,6 +1,4 @@
-<% autogen_exception -%>
 package google
-<% unless version == 'ga' -%>
 
 import (
 	"testing"
@@ -16,14 +14,14 @@ func TestAccApiGatewayApi_apigatewayApiBasicExampleUpdated(t *testing.T) {
 	}
 
 	vcrTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProvidersOiCS,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckApiGatewayApiDestroyProducer(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccApiGatewayApi_apigatewayApiBasicExample(context),
 			},
-		    {
+			{
 				Config: testAccApiGatewayApi_apigatewayApiBasicExampleUpdated(context),
 			},
 		},
@@ -33,7 +31,6 @@ func TestAccApiGatewayApi_apigatewayApiBasicExampleUpdated(t *testing.T) {
 func testAccApiGatewayApi_apigatewayApiBasicExampleUpdated(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_api_gateway_api" "api" {
-  provider = google-beta
   api_id = "api%{random_suffix}"
   display_name = "Magical API"
   labels = {
@@ -42,5 +39,3 @@ resource "google_api_gateway_api" "api" {
 }
 `, context)
 }
-
-<% end -%>
@@ -1,6 +1,4 @@
-<% autogen_exception -%>
 package google
-<% unless version == 'ga' -%>
 
 import (
 	"testing"
@@ -16,8 +14,8 @@ func TestAccApiGatewayGateway_apigatewayGatewayBasicExampleUpdated(t *testing.T)
 	}
 
 	vcrTest(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
-		Providers: testAccProvidersOiCS,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckApiGatewayGatewayDestroyProducer(t),
 		Steps: []resource.TestStep{
 			{
@@ -33,12 +31,10 @@ func TestAccApiGatewayGateway_apigatewayGatewayBasicExampleUpdated(t *testing.T)
 func testAccApiGatewayGateway_apigatewayGatewayBasicExampleUpdated(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_api_gateway_api" "api_gw" {
-  provider = google-beta
   api_id = "tf-test-api-gw%{random_suffix}"
 }
 
 resource "google_api_gateway_api_config" "api_gw" {
-  provider = google-beta
   api = google_api_gateway_api.api_gw.api_id
   api_config_id = "tf-test-api-gw%{random_suffix}"
 	lifecycle {
@@ -54,7 +50,6 @@ resource "google_api_gateway_api_config" "api_gw" {
 }
 
 resource "google_api_gateway_gateway" "api_gw" {
-  provider = google-beta
   api_config = google_api_gateway_api_config.api_gw.id
   gateway_id = "tf-test-api-gw%{random_suffix}"
   display_name = "MM Dev API Gateway"
@@ -64,4 +59,3 @@ resource "google_api_gateway_gateway" "api_gw" {
 }
 `, context)
 }
-<% end -%>
@@ -173,7 +173,8 @@ func TestAccBigtableInstance_kms(t *testing.T) {
 	t.Parallel()
 
 	kms1 := BootstrapKMSKeyInLocation(t, "us-central1")
-	kms2 := BootstrapKMSKeyInLocation(t, "us-east1")
+	kms2 := BootstrapKMSKeyWithPurposeInLocationAndName(t, "ENCRYPT_DECRYPT", "us-central1", "tf-bootstrap-bigtable-key2")
+	kmsOtherRegion := BootstrapKMSKeyInLocation(t, "us-east1")
 	pid := getTestProjectFromEnv()
 	instanceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
@@ -197,6 +198,12 @@ func TestAccBigtableInstance_kms(t *testing.T) {
 				PlanOnly:           true,
 				ExpectNonEmptyPlan: true,
 			},
+			{
+				// The key must be in the region of the cluster
+				Config:      testAccBigtableInstance_kms(pid, instanceName, kmsOtherRegion.CryptoKey.Name, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be in the region of the cluster"),
+			},
 		},
 	})
 }
@@ -374,6 +381,158 @@ func TestAccBigtableInstance_enableAndDisableAutoscalingWithoutNumNodes(t *testi
 	})
 }
 
+func TestAccBigtableInstance_nodeScalingFactor(t *testing.T) {
+	// bigtable instance does not use the shared HTTP client, this test creates an instance
+	skipIfVcr(t)
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigtableInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBigtableInstance_nodeScalingFactor(instanceName, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a multiple of 2"),
+			},
+			{
+				Config: testAccBigtableInstance_nodeScalingFactor(instanceName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigtable_instance.instance", "cluster.0.node_scaling_factor", "NodeScalingFactor2X"),
+				),
+			},
+			{
+				ResourceName:            "google_bigtable_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "instance_type"}, // we don't read instance type back
+			},
+			{
+				Config: testAccBigtableInstance_nodeScalingFactor(instanceName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigtable_instance.instance", "cluster.0.num_nodes", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateBigtableClusters(t *testing.T) {
+	cases := map[string]struct {
+		Clusters    []interface{}
+		DefaultZone string
+		ExpectError bool
+	}{
+		"key in the cluster region": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":   "c1",
+					"zone":         "us-central1-b",
+					"kms_key_name": "projects/p/locations/us-central1/keyRings/kr/cryptoKeys/k",
+				},
+			},
+		},
+		"key in the provider zone region": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":   "c1",
+					"kms_key_name": "projects/p/locations/us-central1/keyRings/kr/cryptoKeys/k",
+				},
+			},
+			DefaultZone: "us-central1-f",
+		},
+		"key in another region": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":   "c1",
+					"zone":         "us-central1-b",
+					"kms_key_name": "projects/p/locations/us-east1/keyRings/kr/cryptoKeys/k",
+				},
+			},
+			ExpectError: true,
+		},
+		"key on some clusters only": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":   "c1",
+					"zone":         "us-central1-b",
+					"kms_key_name": "projects/p/locations/us-central1/keyRings/kr/cryptoKeys/k",
+				},
+				map[string]interface{}{
+					"cluster_id": "c2",
+					"zone":       "us-east1-b",
+				},
+			},
+			ExpectError: true,
+		},
+		"min nodes greater than max nodes": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id": "c1",
+					"autoscaling_config": []interface{}{
+						map[string]interface{}{"min_nodes": 5, "max_nodes": 2, "cpu_target": 50},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		"2x scaling factor with even nodes": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":          "c1",
+					"num_nodes":           4,
+					"node_scaling_factor": "NodeScalingFactor2X",
+				},
+			},
+		},
+		"2x scaling factor with odd nodes": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":          "c1",
+					"num_nodes":           3,
+					"node_scaling_factor": "NodeScalingFactor2X",
+				},
+			},
+			ExpectError: true,
+		},
+		"2x scaling factor with odd autoscaling max nodes": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":          "c1",
+					"num_nodes":           3,
+					"node_scaling_factor": "NodeScalingFactor2X",
+					"autoscaling_config": []interface{}{
+						map[string]interface{}{"min_nodes": 2, "max_nodes": 5, "cpu_target": 50},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		"1x scaling factor with odd nodes": {
+			Clusters: []interface{}{
+				map[string]interface{}{
+					"cluster_id":          "c1",
+					"num_nodes":           3,
+					"node_scaling_factor": "NodeScalingFactor1X",
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		err := validateBigtableClusters(tc.Clusters, tc.DefaultZone)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func testAccCheckBigtableInstanceDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		var ctx = context.Background()
@@ -679,3 +838,19 @@ func autoscalingClusterConfigWithStorageTarget(instanceName string, min int, max
 
 	}`, instanceName, instanceName, min, max, cpuTarget, storageTarget)
 }
+
+func testAccBigtableInstance_nodeScalingFactor(instanceName string, numNodes int) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+  name = "%s"
+  cluster {
+    cluster_id          = "%s"
+    zone                = "us-central1-b"
+    num_nodes           = %d
+    storage_type        = "SSD"
+    node_scaling_factor = "NodeScalingFactor2X"
+  }
+  deletion_protection = false
+}
+`, instanceName, instanceName, numNodes)
+}
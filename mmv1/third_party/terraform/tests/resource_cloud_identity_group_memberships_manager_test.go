@@ -0,0 +1,115 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudIdentityGroupMembershipsManager_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_domain":    getTestOrgDomainFromEnv(t),
+		"cust_id":       getTestCustIdFromEnv(t),
+		"identity_user": getTestIdentityUserFromEnv(t),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIdentityGroupMembershipsManager_basic(context),
+			},
+			{
+				ResourceName:      "google_cloud_identity_group_memberships_manager.members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudIdentityGroupMembershipsManager_update(context),
+			},
+			{
+				ResourceName:            "google_cloud_identity_group_memberships_manager.members",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"batch_size"},
+			},
+			{
+				Config: testAccCloudIdentityGroupMembershipsManager_basic(context),
+			},
+			{
+				ResourceName:      "google_cloud_identity_group_memberships_manager.members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudIdentityGroupMembershipsManager_groups(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_identity_group" "group" {
+  display_name = "tf-test-my-identity-group%{random_suffix}"
+
+  parent = "customers/%{cust_id}"
+
+  group_key {
+    id = "tf-test-my-identity-group%{random_suffix}@%{org_domain}"
+  }
+
+  labels = {
+    "cloudidentity.googleapis.com/groups.discussion_forum" = ""
+  }
+}
+
+resource "google_cloud_identity_group" "child-group" {
+  display_name = "tf-test-my-identity-group%{random_suffix}-child"
+
+  parent = "customers/%{cust_id}"
+
+  group_key {
+    id = "tf-test-my-identity-group%{random_suffix}-child@%{org_domain}"
+  }
+
+  labels = {
+    "cloudidentity.googleapis.com/groups.discussion_forum" = ""
+  }
+}
+`, context)
+}
+
+func testAccCloudIdentityGroupMembershipsManager_basic(context map[string]interface{}) string {
+	return testAccCloudIdentityGroupMembershipsManager_groups(context) + Nprintf(`
+resource "google_cloud_identity_group_memberships_manager" "members" {
+  group = google_cloud_identity_group.group.id
+
+  members {
+    id    = "%{identity_user}@%{org_domain}"
+    roles = ["MEMBER"]
+  }
+}
+`, context)
+}
+
+func testAccCloudIdentityGroupMembershipsManager_update(context map[string]interface{}) string {
+	return testAccCloudIdentityGroupMembershipsManager_groups(context) + Nprintf(`
+resource "google_cloud_identity_group_memberships_manager" "members" {
+  group      = google_cloud_identity_group.group.id
+  batch_size = 2
+
+  members {
+    id    = "%{identity_user}@%{org_domain}"
+    roles = ["MEMBER", "MANAGER"]
+  }
+
+  members {
+    id          = google_cloud_identity_group.child-group.group_key[0].id
+    roles       = ["MEMBER"]
+    expire_time = "2099-01-01T00:00:00Z"
+  }
+}
+`, context)
+}
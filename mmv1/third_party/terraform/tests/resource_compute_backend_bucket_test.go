@@ -95,6 +95,37 @@ func TestAccComputeBackendBucket_withCdnPolicy(t *testing.T) {
 	})
 }
 
+func TestAccComputeBackendBucket_withAdvancedCdnPolicy(t *testing.T) {
+	t.Parallel()
+
+	backendName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	storageName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeBackendBucket_withAdvancedCdnPolicy(backendName, storageName),
+			},
+			{
+				ResourceName:      "google_compute_backend_bucket.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeBackendBucket_withAdvancedCdnPolicyUpdate(backendName, storageName),
+			},
+			{
+				ResourceName:      "google_compute_backend_bucket.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeBackendBucket_withSecurityPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -272,6 +303,67 @@ resource "google_storage_bucket" "bucket" {
 `, backendName, age, max_ttl, ttl, ttl, ttl, code, ttl, storageName)
 }
 
+func testAccComputeBackendBucket_withAdvancedCdnPolicy(backendName, storageName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_bucket" "foobar" {
+  name             = "%s"
+  bucket_name      = google_storage_bucket.bucket.name
+  enable_cdn       = true
+  compression_mode = "AUTOMATIC"
+  cdn_policy {
+    cache_mode         = "CACHE_ALL_STATIC"
+    request_coalescing = true
+    cache_key_policy {
+      query_string_whitelist = ["image_version"]
+    }
+    negative_caching = true
+    negative_caching_policy {
+      code = 404
+      ttl  = 60
+    }
+  }
+}
+
+resource "google_storage_bucket" "bucket" {
+  name     = "%s"
+  location = "EU"
+}
+`, backendName, storageName)
+}
+
+func testAccComputeBackendBucket_withAdvancedCdnPolicyUpdate(backendName, storageName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_bucket" "foobar" {
+  name             = "%s"
+  bucket_name      = google_storage_bucket.bucket.name
+  enable_cdn       = true
+  compression_mode = "DISABLED"
+  cdn_policy {
+    cache_mode         = "CACHE_ALL_STATIC"
+    request_coalescing = false
+    cache_key_policy {
+      query_string_whitelist = ["image_version", "locale"]
+      include_http_headers   = ["X-My-Header-Field"]
+    }
+    negative_caching = true
+    negative_caching_policy {
+      code = 404
+      ttl  = 120
+    }
+    negative_caching_policy {
+      code = 410
+      ttl  = 300
+    }
+  }
+}
+
+resource "google_storage_bucket" "bucket" {
+  name     = "%s"
+  location = "EU"
+}
+`, backendName, storageName)
+}
+
 func testAccComputeBackendBucket_withSecurityPolicy(bucketName, polName, polLink string) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_bucket" "image_backend" {
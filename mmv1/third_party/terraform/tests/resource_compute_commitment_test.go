@@ -0,0 +1,190 @@
+package google
+
+import (
+	"testing"
+)
+
+func computeCommitmentTestResource(resourceType string, amount int, acceleratorType string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":             resourceType,
+		"amount":           amount,
+		"accelerator_type": acceleratorType,
+	}
+}
+
+func TestValidateComputeCommitmentResources(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"vcpu and memory": {
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("VCPU", 4, ""),
+					computeCommitmentTestResource("MEMORY", 9216, ""),
+				},
+			},
+		},
+		"gpus and local ssds": {
+			After: map[string]interface{}{
+				"category": "MACHINE",
+				"resources": []interface{}{
+					computeCommitmentTestResource("VCPU", 12, ""),
+					computeCommitmentTestResource("MEMORY", 87040, ""),
+					computeCommitmentTestResource("ACCELERATOR", 1, "nvidia-tesla-a100"),
+					computeCommitmentTestResource("ACCELERATOR", 2, "nvidia-l4"),
+					computeCommitmentTestResource("LOCAL_SSD", 375, ""),
+				},
+			},
+		},
+		"vcpu without memory": {
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("VCPU", 4, ""),
+				},
+			},
+			ExpectError: true,
+		},
+		"accelerator without accelerator type": {
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 1, ""),
+				},
+			},
+			ExpectError: true,
+		},
+		"accelerator type on local ssd": {
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("LOCAL_SSD", 375, "nvidia-l4"),
+				},
+			},
+			ExpectError: true,
+		},
+		"duplicate accelerator type": {
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 1, "nvidia-l4"),
+					computeCommitmentTestResource("ACCELERATOR", 2, "nvidia-l4"),
+				},
+			},
+			ExpectError: true,
+		},
+		"license commitment": {
+			After: map[string]interface{}{
+				"category": "LICENSE",
+				"license_resource": []interface{}{
+					map[string]interface{}{"license": "https://www.googleapis.com/compute/v1/projects/suse-sap-cloud/global/licenses/sles-sap-12"},
+				},
+			},
+		},
+		"license commitment without license": {
+			After: map[string]interface{}{
+				"category": "LICENSE",
+			},
+			ExpectError: true,
+		},
+		"license commitment with resources": {
+			After: map[string]interface{}{
+				"category": "LICENSE",
+				"license_resource": []interface{}{
+					map[string]interface{}{"license": "https://www.googleapis.com/compute/v1/projects/suse-sap-cloud/global/licenses/sles-sap-12"},
+				},
+				"resources": []interface{}{
+					computeCommitmentTestResource("LOCAL_SSD", 375, ""),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{After: tc.After}
+		err := validateComputeCommitmentResources(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestValidateComputeCommitmentChanges(t *testing.T) {
+	cases := map[string]struct {
+		Before      map[string]interface{}
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"create": {
+			Before: map[string]interface{}{},
+			After: map[string]interface{}{
+				"plan": "TWELVE_MONTH",
+				"resources": []interface{}{
+					computeCommitmentTestResource("LOCAL_SSD", 375, ""),
+				},
+			},
+		},
+		"plan upgrade": {
+			Before: map[string]interface{}{"plan": "TWELVE_MONTH"},
+			After:  map[string]interface{}{"plan": "THIRTY_SIX_MONTH"},
+		},
+		"plan downgrade": {
+			Before:      map[string]interface{}{"plan": "THIRTY_SIX_MONTH"},
+			After:       map[string]interface{}{"plan": "TWELVE_MONTH"},
+			ExpectError: true,
+		},
+		"resources increased and added": {
+			Before: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("VCPU", 4, ""),
+					computeCommitmentTestResource("MEMORY", 9216, ""),
+				},
+			},
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("MEMORY", 18432, ""),
+					computeCommitmentTestResource("VCPU", 8, ""),
+					computeCommitmentTestResource("ACCELERATOR", 1, "nvidia-l4"),
+				},
+			},
+		},
+		"resource decreased": {
+			Before: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 2, "nvidia-l4"),
+				},
+			},
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 1, "nvidia-l4"),
+				},
+			},
+			ExpectError: true,
+		},
+		"resource removed": {
+			Before: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 2, "nvidia-l4"),
+				},
+			},
+			After: map[string]interface{}{
+				"resources": []interface{}{
+					computeCommitmentTestResource("ACCELERATOR", 2, "nvidia-tesla-t4"),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{Before: tc.Before, After: tc.After}
+		err := validateComputeCommitmentChanges(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
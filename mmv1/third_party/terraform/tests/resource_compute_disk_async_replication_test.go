@@ -0,0 +1,91 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeDiskAsyncReplication_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskAsyncReplication_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_compute_disk_async_replication.replication", "secondary_disk.0.state"),
+				),
+			},
+		},
+	})
+}
+
+func TestComputeAsyncReplicationSecondaryState(t *testing.T) {
+	t.Parallel()
+
+	primary := map[string]interface{}{
+		"resourceStatus": map[string]interface{}{
+			"asyncSecondaryDisks": map[string]interface{}{
+				"https://www.googleapis.com/compute/v1/projects/p/zones/us-east4-a/disks/secondary": map[string]interface{}{
+					"state": "ACTIVE",
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		Secondary string
+		Expected  string
+	}{
+		"secondary disk of the primary": {
+			Secondary: "projects/p/zones/us-east4-a/disks/secondary",
+			Expected:  "ACTIVE",
+		},
+		"other disk": {
+			Secondary: "projects/p/zones/us-east4-b/disks/secondary",
+			Expected:  "",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := computeAsyncReplicationSecondaryState(primary, tc.Secondary); got != tc.Expected {
+			t.Errorf("%s: expected state %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func testAccComputeDiskAsyncReplication_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_disk" "primary" {
+  name = "tf-test-primary-%{random_suffix}"
+  type = "pd-ssd"
+  zone = "us-central1-a"
+  size = 10
+}
+
+resource "google_compute_disk" "secondary" {
+  name = "tf-test-secondary-%{random_suffix}"
+  type = "pd-ssd"
+  zone = "us-east4-a"
+  size = 10
+
+  async_primary_disk {
+    disk = google_compute_disk.primary.id
+  }
+}
+
+resource "google_compute_disk_async_replication" "replication" {
+  primary_disk = google_compute_disk.primary.id
+  secondary_disk {
+    disk = google_compute_disk.secondary.id
+  }
+}
+`, context)
+}
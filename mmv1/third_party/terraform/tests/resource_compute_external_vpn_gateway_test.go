@@ -0,0 +1,55 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeExternalVPNGateway_updateLabels(t *testing.T) {
+	t.Parallel()
+
+	rnd := randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeExternalVPNGateway_updateLabels(rnd, "test", "test"),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeExternalVPNGateway_updateLabels(rnd, "test-updated", "test-updated"),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeExternalVPNGateway_updateLabels(suffix, key, value string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "tf-test-external-gateway-%s"
+  redundancy_type = "SINGLE_IP_INTERNALLY_REDUNDANT"
+  description     = "An externally managed VPN gateway"
+  labels = {
+    %s = "%s"
+  }
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+}
+`, suffix, key, value)
+}
@@ -0,0 +1,119 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeInstance_defaultLabels(t *testing.T) {
+	skipIfNoSharedLabelsModel(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_defaultLabels(context, "default_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "labels.%", "1"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "labels.my_key", "my_value"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "terraform_labels.%", "2"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "terraform_labels.default_key", "default_value"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "effective_labels.%", "2"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", "tf-test-"+context["random_suffix"].(string), []string{}),
+			{
+				// Labels configured on the resource override the provider's default labels
+				Config: testAccComputeInstance_defaultLabelsOverridden(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "labels.%", "2"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "terraform_labels.%", "2"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "terraform_labels.default_key", "overridden_value"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "effective_labels.default_key", "overridden_value"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", "tf-test-"+context["random_suffix"].(string), []string{}),
+			{
+				Config: testAccComputeInstance_withoutDefaultLabels(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "labels.%", "1"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "terraform_labels.%", "1"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "effective_labels.%", "1"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", "tf-test-"+context["random_suffix"].(string), []string{}),
+		},
+	})
+}
+
+func testAccComputeInstance_defaultLabels(context map[string]interface{}, defaultValue string) string {
+	context["default_value"] = defaultValue
+	return Nprintf(`
+provider "google" {
+  default_labels = {
+    default_key = "%{default_value}"
+  }
+}
+`, context) + testAccComputeInstance_labelsResource(context, `
+  labels = {
+    my_key = "my_value"
+  }
+`)
+}
+
+func testAccComputeInstance_defaultLabelsOverridden(context map[string]interface{}) string {
+	return Nprintf(`
+provider "google" {
+  default_labels = {
+    default_key = "default_value"
+  }
+}
+`, context) + testAccComputeInstance_labelsResource(context, `
+  labels = {
+    my_key      = "my_value"
+    default_key = "overridden_value"
+  }
+`)
+}
+
+func testAccComputeInstance_withoutDefaultLabels(context map[string]interface{}) string {
+	return testAccComputeInstance_labelsResource(context, `
+  labels = {
+    my_key = "my_value"
+  }
+`)
+}
+
+func testAccComputeInstance_labelsResource(context map[string]interface{}, labels string) string {
+	context["labels"] = labels
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+  name         = "tf-test-%{random_suffix}"
+  machine_type = "e2-medium"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = data.google_compute_image.my_image.self_link
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+%{labels}}
+`, context)
+}
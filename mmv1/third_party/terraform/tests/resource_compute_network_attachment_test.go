@@ -0,0 +1,76 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeNetworkAttachment_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkAttachmentDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNetworkAttachment_update(context, "ACCEPT_AUTOMATIC", ""),
+			},
+			{
+				ResourceName:            "google_compute_network_attachment.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+			{
+				Config: testAccComputeNetworkAttachment_update(context, "ACCEPT_MANUAL", "google_project.producer.project_id"),
+			},
+			{
+				ResourceName:            "google_compute_network_attachment.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
+
+func testAccComputeNetworkAttachment_update(context map[string]interface{}, preference, acceptedProject string) string {
+	context["connection_preference"] = preference
+	context["producer_accept_lists"] = acceptedProject
+	return Nprintf(`
+resource "google_project" "producer" {
+  project_id      = "tf-test-prj%{random_suffix}"
+  name            = "tf-test-prj%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_compute_network" "default" {
+  name                    = "tf-test-network%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-subnetwork%{random_suffix}"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+  ip_cidr_range = "10.0.0.0/16"
+}
+
+resource "google_compute_network_attachment" "default" {
+  name                  = "tf-test-attachment%{random_suffix}"
+  region                = "us-central1"
+  connection_preference = "%{connection_preference}"
+  subnetworks           = [google_compute_subnetwork.default.self_link]
+  producer_accept_lists = [%{producer_accept_lists}]
+}
+`, context)
+}
@@ -0,0 +1,172 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComputeRegionInstanceTemplate_basic(t *testing.T) {
+	t.Parallel()
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionInstanceTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionInstanceTemplate_basic(randString(t, 10)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_instance_template.foobar", "region", "us-central1"),
+					resource.TestMatchResourceAttr("google_compute_region_instance_template.foobar", "self_link", regexp.MustCompile("/regions/us-central1/instanceTemplates/")),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRegionInstanceTemplate_crossReferences(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionInstanceTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionInstanceTemplate_crossReferences(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_instance_from_template.foobar", "machine_type", "e2-medium"),
+				),
+			},
+			{
+				ResourceName:            "google_compute_region_instance_group_manager.igm",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func testAccCheckComputeRegionInstanceTemplateDestroyProducer(t *testing.T) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "google_compute_region_instance_template" {
+				continue
+			}
+
+			splits := strings.Split(rs.Primary.ID, "/")
+			_, err := config.NewComputeClient(config.userAgent).RegionInstanceTemplates.Get(
+				config.Project, rs.Primary.Attributes["region"], splits[len(splits)-1]).Do()
+			if err == nil {
+				return fmt.Errorf("Region instance template still exists")
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeRegionInstanceTemplate_basic(suffix string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_region_instance_template" "foobar" {
+  name           = "tf-test-instance-template-%s"
+  region         = "us-central1"
+  machine_type   = "e2-medium"
+  can_ip_forward = false
+  tags           = ["foo", "bar"]
+
+  disk {
+    source_image = data.google_compute_image.my_image.self_link
+    auto_delete  = true
+    boot         = true
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  scheduling {
+    preemptible       = false
+    automatic_restart = true
+  }
+
+  metadata = {
+    foo = "bar"
+  }
+
+  service_account {
+    scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+  }
+
+  labels = {
+    my_label = "foobar"
+  }
+}
+`, suffix)
+}
+
+func testAccComputeRegionInstanceTemplate_crossReferences(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-11"
+  project = "debian-cloud"
+}
+
+resource "google_compute_region_instance_template" "template" {
+  name         = "tf-test-instance-template-%{random_suffix}"
+  region       = "us-central1"
+  machine_type = "e2-medium"
+
+  disk {
+    source_image = data.google_compute_image.my_image.self_link
+    auto_delete  = true
+    boot         = true
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_compute_region_instance_group_manager" "igm" {
+  name               = "tf-test-igm-%{random_suffix}"
+  base_instance_name = "tf-test-igm-%{random_suffix}"
+  region             = "us-central1"
+  target_size        = 0
+
+  version {
+    instance_template = google_compute_region_instance_template.template.self_link
+    name              = "primary"
+  }
+}
+
+resource "google_compute_instance_from_template" "foobar" {
+  name = "tf-test-instance-%{random_suffix}"
+  zone = "us-central1-a"
+
+  source_instance_template = google_compute_region_instance_template.template.self_link
+}
+`, context)
+}
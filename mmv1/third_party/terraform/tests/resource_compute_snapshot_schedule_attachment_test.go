@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComputeSnapshotScheduleAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-disk-%s", randString(t, 10))
+	policyName := fmt.Sprintf("tf-test-policy-%s", randString(t, 10))
+	importID := fmt.Sprintf("%s/us-central1-a/%s/%s", getTestProjectFromEnv(), diskName, policyName)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Check destroy isn't a good test here, see comment on testCheckSnapshotScheduleIsNowDetached
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotScheduleAttachment_resources(diskName, policyName) + testAccComputeSnapshotScheduleAttachment_byName(),
+			},
+			{
+				ResourceName:      "google_compute_snapshot_schedule_attachment.attachment",
+				ImportStateId:     importID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeSnapshotScheduleAttachment_resources(diskName, policyName) + testAccComputeSnapshotScheduleAttachment_bySelfLink(),
+			},
+			{
+				ResourceName:      "google_compute_snapshot_schedule_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeSnapshotScheduleAttachment_resources(diskName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSnapshotScheduleIsNowDetached(t, diskName, policyName),
+				),
+			},
+		},
+	})
+}
+
+// testCheckSnapshotScheduleIsNowDetached queries a disk and confirms that a
+// specific snapshot schedule is no longer attached to it.
+//
+// This is being used instead of a CheckDestroy method because destroying the
+// attachment should only detach the snapshot schedule, while a normal check
+// destroy would run after the disk and policy are deleted as well.
+func testCheckSnapshotScheduleIsNowDetached(t *testing.T, diskName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := googleProviderConfig(t)
+
+		disk, err := config.NewComputeClient(config.userAgent).Disks.Get(getTestProjectFromEnv(), "us-central1-a", diskName).Do()
+		if err != nil {
+			return err
+		}
+
+		if findResourcePolicy(disk.ResourcePolicies, policyName) != "" {
+			return fmt.Errorf("snapshot schedule is still attached to disk")
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeSnapshotScheduleAttachment_byName() string {
+	return `
+resource "google_compute_snapshot_schedule_attachment" "attachment" {
+  disk              = google_compute_disk.disk.name
+  snapshot_schedule = google_compute_resource_policy.policy.name
+  zone              = "us-central1-a"
+}
+`
+}
+
+func testAccComputeSnapshotScheduleAttachment_bySelfLink() string {
+	return `
+resource "google_compute_snapshot_schedule_attachment" "attachment" {
+  disk              = google_compute_disk.disk.self_link
+  snapshot_schedule = google_compute_resource_policy.policy.self_link
+}
+`
+}
+
+func testAccComputeSnapshotScheduleAttachment_resources(diskName, policyName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "disk" {
+  name = "%s"
+  size = 10
+  type = "pd-standard"
+  zone = "us-central1-a"
+}
+
+resource "google_compute_resource_policy" "policy" {
+  name   = "%s"
+  region = "us-central1"
+  snapshot_schedule_policy {
+    schedule {
+      daily_schedule {
+        days_in_cycle = 1
+        start_time    = "04:00"
+      }
+    }
+  }
+}
+`, diskName, policyName)
+}
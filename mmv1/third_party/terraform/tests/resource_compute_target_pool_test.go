@@ -79,6 +79,44 @@ func TestAccComputeTargetPool_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeTargetPool_withSecurityPolicy(t *testing.T) {
+	t.Parallel()
+
+	suffix := randString(t, 10)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeTargetPoolDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeTargetPool_withSecurityPolicy(suffix, "google_compute_region_security_policy.policy1.self_link"),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeTargetPool_withSecurityPolicy(suffix, "google_compute_region_security_policy.policy2.self_link"),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeTargetPool_withSecurityPolicy(suffix, `""`),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeTargetPoolDestroyProducer(t *testing.T) func(s *terraform.State) error {
 	return func(s *terraform.State) error {
 		config := googleProviderConfig(t)
@@ -236,3 +274,25 @@ resource "google_compute_instance" "bar" {
 }
 `, tpname, instances, name1, name2)
 }
+
+func testAccComputeTargetPool_withSecurityPolicy(suffix, securityPolicy string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_security_policy" "policy1" {
+  name   = "tf-test-policy1-%s"
+  region = "us-central1"
+  type   = "CLOUD_ARMOR_NETWORK"
+}
+
+resource "google_compute_region_security_policy" "policy2" {
+  name   = "tf-test-policy2-%s"
+  region = "us-central1"
+  type   = "CLOUD_ARMOR_NETWORK"
+}
+
+resource "google_compute_target_pool" "foo" {
+  name            = "tf-test-%s"
+  region          = "us-central1"
+  security_policy = %s
+}
+`, suffix, suffix, suffix, securityPolicy)
+}
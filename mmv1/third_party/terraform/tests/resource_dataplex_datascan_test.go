@@ -0,0 +1,93 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataplexDatascan_triggerUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_name":  getTestProjectFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexDatascanDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexDatascan_onDemand(context),
+			},
+			{
+				ResourceName:            "google_dataplex_datascan.datascan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location", "data_scan_id"},
+			},
+			{
+				Config: testAccDataplexDatascan_scheduled(context),
+			},
+			{
+				ResourceName:            "google_dataplex_datascan.datascan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location", "data_scan_id"},
+			},
+		},
+	})
+}
+
+func testAccDataplexDatascan_onDemand(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataplex_datascan" "datascan" {
+  location     = "us-central1"
+  data_scan_id = "tf-test-datascan-%{random_suffix}"
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/samples/tables/shakespeare"
+  }
+
+  execution_spec {
+    trigger {
+      on_demand {}
+    }
+  }
+
+  data_profile_spec {}
+
+  project = "%{project_name}"
+}
+`, context)
+}
+
+func testAccDataplexDatascan_scheduled(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataplex_datascan" "datascan" {
+  location     = "us-central1"
+  data_scan_id = "tf-test-datascan-%{random_suffix}"
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/samples/tables/shakespeare"
+  }
+
+  execution_spec {
+    trigger {
+      schedule {
+        cron = "TZ=America/New_York 1 1 * * *"
+      }
+    }
+  }
+
+  data_profile_spec {
+    sampling_percent           = 50
+    catalog_publishing_enabled = true
+  }
+
+  project = "%{project_name}"
+}
+`, context)
+}
@@ -0,0 +1,56 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDocumentAIProcessorDefaultVersion_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentAIProcessorDefaultVersion(context, "pretrained-ocr-v1.0-2020-09-23"),
+			},
+			{
+				ResourceName:            "google_document_ai_processor_default_version.processor",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"processor"},
+			},
+			{
+				Config: testAccDocumentAIProcessorDefaultVersion(context, "pretrained-ocr-v1.2-2022-11-10"),
+			},
+			{
+				ResourceName:            "google_document_ai_processor_default_version.processor",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"processor"},
+			},
+		},
+	})
+}
+
+func testAccDocumentAIProcessorDefaultVersion(context map[string]interface{}, version string) string {
+	context["version"] = version
+	return Nprintf(`
+resource "google_document_ai_processor" "processor" {
+  location     = "us"
+  display_name = "tf-test-processor%{random_suffix}"
+  type         = "OCR_PROCESSOR"
+}
+
+resource "google_document_ai_processor_default_version" "processor" {
+  processor = google_document_ai_processor.processor.id
+  version   = "${google_document_ai_processor.processor.id}/processorVersions/%{version}"
+}
+`, context)
+}
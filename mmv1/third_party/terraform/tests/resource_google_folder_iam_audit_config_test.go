@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // Test that an IAM audit config can be applied to a folder
@@ -269,6 +270,57 @@ func TestAccFolderIamAuditConfig_updateNoExemptMembers(t *testing.T) {
 	})
 }
 
+// Test that changing the service of an IAM audit config doesn't leave the old service behind
+func TestAccFolderIamAuditConfig_changeService(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	fname := "tf-test-" + randString(t, 10)
+	service := "cloudkms.googleapis.com"
+	service2 := "cloudsql.googleapis.com"
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Create a new folder
+			{
+				Config: testAccFolderIamBasic(org, fname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccFolderExistingPolicy(t, org, fname),
+				),
+			},
+			// Apply an IAM audit config
+			{
+				Config: testAccFolderAssociateAuditConfigBasic(org, fname, service),
+			},
+			// Move the IAM audit config to another service
+			{
+				Config: testAccFolderAssociateAuditConfigBasic(org, fname, service2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderAuditConfigServiceAbsent(t, org, fname, service),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFolderAuditConfigServiceAbsent(t *testing.T, org, fname, service string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := googleProviderConfig(t)
+		policy, err := getFolderIamPolicyByParentAndDisplayName("organizations/"+org, fname, c)
+		if err != nil {
+			return fmt.Errorf("Failed to retrieve IAM Policy for folder %q: %s", fname, err)
+		}
+		for _, ac := range policy.AuditConfigs {
+			if ac.Service == service {
+				return fmt.Errorf("Expected audit config for service %q to be removed from folder %q", service, fname)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccFolderAssociateAuditConfigBasic(org, fname, service string) string {
 	return fmt.Sprintf(`
 resource "google_folder" "acceptance" {
@@ -81,6 +81,80 @@ func TestAccServiceAccountKey_fromCertificate(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountKey_rotation(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_service_account_key.acceptance"
+	accountID := "a" + randString(t, 10)
+	displayName := "Terraform Test"
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountKey_rotation(accountID, displayName, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(t, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_time"),
+				),
+			},
+			{
+				// A key older than the rotation period is replaced
+				Config: testAccServiceAccountKey_rotation(accountID, displayName, "0s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(t, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_time"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestServiceAccountKeyRotationTime(t *testing.T) {
+	cases := map[string]struct {
+		ValidAfter     string
+		RotationPeriod string
+		Expected       string
+		ExpectError    bool
+	}{
+		"no rotation period": {
+			ValidAfter: "2014-10-02T15:01:23.045123456Z",
+			Expected:   "",
+		},
+		"no valid after": {
+			RotationPeriod: "720h",
+			Expected:       "",
+		},
+		"rotation period": {
+			ValidAfter:     "2014-10-02T15:01:23.045123456Z",
+			RotationPeriod: "720h",
+			Expected:       "2014-11-01T15:01:23Z",
+		},
+		"invalid valid after": {
+			ValidAfter:     "yesterday",
+			RotationPeriod: "720h",
+			ExpectError:    true,
+		},
+	}
+
+	for tn, tc := range cases {
+		rotationTime, err := serviceAccountKeyRotationTime(tc.ValidAfter, tc.RotationPeriod)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got none", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if rotationTime != tc.Expected {
+			t.Errorf("%s: expected rotation time %q, got %q", tn, tc.Expected, rotationTime)
+		}
+	}
+}
+
 func testAccCheckGoogleServiceAccountKeyExists(t *testing.T, r string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -144,3 +218,22 @@ resource "google_service_account_key" "acceptance" {
 }
 `, account, name)
 }
+
+func testAccServiceAccountKey_rotation(account, name, rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "acceptance" {
+  account_id   = "%s"
+  display_name = "%s"
+}
+
+resource "google_service_account_key" "acceptance" {
+  service_account_id = google_service_account.acceptance.name
+  rotation_period    = "%s"
+  rotation_overlap   = "1s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, account, name, rotationPeriod)
+}
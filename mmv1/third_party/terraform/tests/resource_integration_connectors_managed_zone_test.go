@@ -0,0 +1,110 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIntegrationConnectorsManagedZone_integrationConnectorsManagedZoneUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIntegrationConnectorsManagedZoneDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConnectorsManagedZone_managedZone(context, "tf created description", "example"),
+			},
+			{
+				ResourceName:            "google_integration_connectors_managed_zone.zone",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+			{
+				Config: testAccIntegrationConnectorsManagedZone_managedZone(context, "tf updated description", "updated"),
+			},
+			{
+				ResourceName:            "google_integration_connectors_managed_zone.zone",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+		},
+	})
+}
+
+func testAccIntegrationConnectorsManagedZone_managedZone(context map[string]interface{}, description, intent string) string {
+	context["description"] = description
+	context["intent"] = intent
+	return Nprintf(`
+resource "google_project" "target_project" {
+  project_id      = "tf-test-target%{random_suffix}"
+  name            = "tf-test-target%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "dns" {
+  project = google_project.target_project.project_id
+  service = "dns.googleapis.com"
+}
+
+resource "google_project_service" "compute" {
+  project = google_project.target_project.project_id
+  service = "compute.googleapis.com"
+}
+
+data "google_project" "test_project" {
+}
+
+resource "google_project_iam_member" "dns_peer_binding" {
+  project = google_project.target_project.project_id
+  role    = "roles/dns.peer"
+  member  = "serviceAccount:service-${data.google_project.test_project.number}@gcp-sa-connectors.iam.gserviceaccount.com"
+}
+
+resource "google_compute_network" "network" {
+  project                 = google_project.target_project.project_id
+  name                    = "tf-test-network%{random_suffix}"
+  auto_create_subnetworks = false
+  depends_on              = [google_project_service.compute]
+}
+
+resource "google_dns_managed_zone" "zone" {
+  name       = "tf-test-dns%{random_suffix}"
+  dns_name   = "private%{random_suffix}.example.com."
+  visibility = "private"
+  project    = google_project.target_project.project_id
+
+  private_visibility_config {
+    networks {
+      network_url = google_compute_network.network.id
+    }
+  }
+
+  depends_on = [google_project_service.dns]
+}
+
+resource "google_integration_connectors_managed_zone" "zone" {
+  name        = "tf-test-zone%{random_suffix}"
+  description = "%{description}"
+  labels = {
+    intent = "%{intent}"
+  }
+  target_project = google_project.target_project.project_id
+  target_vpc     = google_compute_network.network.name
+  dns            = google_dns_managed_zone.zone.dns_name
+
+  depends_on = [google_project_iam_member.dns_peer_binding, google_dns_managed_zone.zone]
+}
+`, context)
+}
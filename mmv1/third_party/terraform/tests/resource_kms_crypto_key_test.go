@@ -165,6 +165,49 @@ func TestCryptoKeyStateUpgradeV0(t *testing.T) {
 	}
 }
 
+func TestPollCheckKmsCryptoKeyVersionImported(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		State       string
+		ExpectRetry bool
+		ExpectError bool
+	}{
+		"enabled": {
+			State: "ENABLED",
+		},
+		"pending import": {
+			State:       "PENDING_IMPORT",
+			ExpectRetry: true,
+		},
+		"import failed": {
+			State:       "IMPORT_FAILED",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		res := PollCheckKmsCryptoKeyVersionImported(map[string]interface{}{
+			"state":               tc.State,
+			"importFailureReason": "invalid wrapped key",
+		}, nil)
+
+		if !tc.ExpectRetry && !tc.ExpectError {
+			if res != nil {
+				t.Errorf("%s: expected success, got %s", tn, res.Err)
+			}
+			continue
+		}
+		if res == nil {
+			t.Errorf("%s: expected a retry or error, got success", tn)
+			continue
+		}
+		if res.Retryable != tc.ExpectRetry {
+			t.Errorf("%s: expected retryable to be %t, got %t", tn, tc.ExpectRetry, res.Retryable)
+		}
+	}
+}
+
 func TestAccKmsCryptoKey_basic(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,104 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMonitoringMetricsScope_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringMetricsScope(context, `[google_project.first.project_id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_monitoring_metrics_scope.scope", "monitored_projects.#", "1"),
+				),
+			},
+			{
+				Config: testAccMonitoringMetricsScope(context, `[google_project.first.project_id, google_project.second.number]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_monitoring_metrics_scope.scope", "monitored_projects.#", "2"),
+					resource.TestCheckResourceAttrPair("google_monitoring_metrics_scope.scope", "monitored_project_numbers.tf-test-first-"+context["random_suffix"].(string), "google_project.first", "number"),
+				),
+			},
+			{
+				ResourceName:            "google_monitoring_metrics_scope.scope",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"monitored_projects", "monitored_project_numbers"},
+			},
+			{
+				Config: testAccMonitoringMetricsScope(context, `[google_project.second.number]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_monitoring_metrics_scope.scope", "monitored_projects.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMonitoringMetricsScope(context map[string]interface{}, monitoredProjects string) string {
+	context["monitored_projects"] = monitoredProjects
+	return Nprintf(`
+resource "google_project" "scoping" {
+  project_id      = "tf-test-scope-%{random_suffix}"
+  name            = "tf-test-scope-%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "monitoring" {
+  project = google_project.scoping.project_id
+  service = "monitoring.googleapis.com"
+}
+
+resource "google_project" "first" {
+  project_id = "tf-test-first-%{random_suffix}"
+  name       = "tf-test-first-%{random_suffix}"
+  org_id     = "%{org_id}"
+}
+
+resource "google_project" "second" {
+  project_id = "tf-test-second-%{random_suffix}"
+  name       = "tf-test-second-%{random_suffix}"
+  org_id     = "%{org_id}"
+}
+
+resource "google_monitoring_metrics_scope" "scope" {
+  scoping_project    = google_project_service.monitoring.project
+  monitored_projects = %{monitored_projects}
+}
+`, context)
+}
+
+func TestDiffMonitoringMetricsScopeProjects(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]bool{"111": true, "222": true, "333": true}
+	desired := map[string]bool{"333": true, "444": true, "555": true}
+
+	toAdd, toRemove := diffMonitoringMetricsScopeProjects(current, desired)
+	if !reflect.DeepEqual(toAdd, []string{"444", "555"}) {
+		t.Errorf("expected to add [444 555], got %v", toAdd)
+	}
+	if !reflect.DeepEqual(toRemove, []string{"111", "222"}) {
+		t.Errorf("expected to remove [111 222], got %v", toRemove)
+	}
+
+	toAdd, toRemove = diffMonitoringMetricsScopeProjects(current, current)
+	if len(toAdd) != 0 || len(toRemove) != 0 {
+		t.Errorf("expected no changes, got add %v and remove %v", toAdd, toRemove)
+	}
+}
@@ -0,0 +1,178 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkConnectivitySpoke_linkedVpcNetworkUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkConnectivitySpokeDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConnectivitySpoke_linkedVpcNetwork(context, `["10.10.0.0/16"]`, `["10.0.0.0/8"]`),
+			},
+			{
+				ResourceName:            "google_network_connectivity_spoke.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+			{
+				Config: testAccNetworkConnectivitySpoke_linkedVpcNetwork(context, `["10.10.0.0/16", "10.20.0.0/16"]`, `["10.0.0.0/8", "198.51.100.0/23"]`),
+			},
+			{
+				ResourceName:            "google_network_connectivity_spoke.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
+
+func TestAccNetworkConnectivitySpoke_routerApplianceInstancesUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkConnectivitySpokeDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConnectivitySpoke_routerApplianceInstances(context, false),
+			},
+			{
+				ResourceName:            "google_network_connectivity_spoke.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+			{
+				Config: testAccNetworkConnectivitySpoke_routerApplianceInstances(context, true),
+			},
+			{
+				ResourceName:            "google_network_connectivity_spoke.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
+
+func testAccNetworkConnectivitySpoke_linkedVpcNetwork(context map[string]interface{}, excludeExportRanges, includeExportRanges string) string {
+	context["exclude_export_ranges"] = excludeExportRanges
+	context["include_export_ranges"] = includeExportRanges
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_network_connectivity_hub" "basic_hub" {
+  name = "tf-test-hub%{random_suffix}"
+}
+
+resource "google_network_connectivity_spoke" "primary" {
+  name     = "tf-test-spoke%{random_suffix}"
+  location = "global"
+  hub      = google_network_connectivity_hub.basic_hub.id
+  linked_vpc_network {
+    uri                   = google_compute_network.network.self_link
+    exclude_export_ranges = %{exclude_export_ranges}
+    include_export_ranges = %{include_export_ranges}
+  }
+}
+`, context)
+}
+
+func testAccNetworkConnectivitySpoke_routerApplianceInstances(context map[string]interface{}, secondInstance bool) string {
+	context["second_instance"] = ""
+	if secondInstance {
+		context["second_instance"] = `
+    instances {
+      virtual_machine = google_compute_instance.instance2.self_link
+      ip_address      = "10.0.0.3"
+    }`
+	}
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "subnetwork" {
+  name          = "tf-test-subnet%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/28"
+  region        = "us-central1"
+  network       = google_compute_network.network.self_link
+}
+
+resource "google_compute_instance" "instance" {
+  name           = "tf-test-instance%{random_suffix}"
+  machine_type   = "e2-medium"
+  can_ip_forward = true
+  zone           = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "projects/debian-cloud/global/images/debian-10-buster-v20210817"
+    }
+  }
+
+  network_interface {
+    subnetwork = google_compute_subnetwork.subnetwork.name
+    network_ip = "10.0.0.2"
+  }
+}
+
+resource "google_compute_instance" "instance2" {
+  name           = "tf-test-instance2%{random_suffix}"
+  machine_type   = "e2-medium"
+  can_ip_forward = true
+  zone           = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "projects/debian-cloud/global/images/debian-10-buster-v20210817"
+    }
+  }
+
+  network_interface {
+    subnetwork = google_compute_subnetwork.subnetwork.name
+    network_ip = "10.0.0.3"
+  }
+}
+
+resource "google_network_connectivity_hub" "basic_hub" {
+  name = "tf-test-hub%{random_suffix}"
+}
+
+resource "google_network_connectivity_spoke" "primary" {
+  name     = "tf-test-spoke%{random_suffix}"
+  location = "us-central1"
+  hub      = google_network_connectivity_hub.basic_hub.id
+  linked_router_appliance_instances {
+    instances {
+      virtual_machine = google_compute_instance.instance.self_link
+      ip_address      = "10.0.0.2"
+    }%{second_instance}
+    site_to_site_data_transfer = true
+  }
+}
+`, context)
+}
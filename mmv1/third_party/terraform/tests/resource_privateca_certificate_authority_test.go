@@ -119,6 +119,39 @@ func TestAccPrivatecaCertificateAuthority_rootCaManageDesiredState(t *testing.T)
 	})
 }
 
+func TestAccPrivatecaCertificateAuthority_subordinateCaActivatedByThirdPartyIssuer(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"pool_name":     BootstrapSharedCaPoolInLocation(t, "us-central1"),
+		"pool_location": "us-central1",
+		"random_suffix": randString(t, 10),
+	}
+
+	resourceName := "google_privateca_certificate_authority.sub-ca"
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivatecaCertificateAuthorityDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivatecaCertificateAuthority_subordinateCaAwaitingActivation(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "AWAITING_USER_ACTIVATION"),
+					resource.TestCheckResourceAttrSet(resourceName, "pem_csr"),
+				),
+			},
+			{
+				Config: testAccPrivatecaCertificateAuthority_subordinateCaActivated(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "pem_csr", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccPrivatecaCertificateAuthority_privatecaCertificateAuthorityBasicRoot(context map[string]interface{}) string {
 	return Nprintf(`
 resource "google_privateca_certificate_authority" "default" {
@@ -284,3 +317,179 @@ resource "google_privateca_certificate_authority" "default" {
 }
 `, context)
 }
+
+func testAccPrivatecaCertificateAuthority_subordinateCaAwaitingActivation(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_privateca_certificate_authority" "root-ca" {
+	pool = "%{pool_name}"
+	certificate_authority_id = "tf-test-my-certificate-authority-%{random_suffix}-root"
+	location = "%{pool_location}"
+	deletion_protection = false
+	skip_grace_period = true
+	ignore_active_certificates_on_deletion = true
+	config {
+		subject_config {
+		subject {
+			organization = "HashiCorp"
+			common_name = "my-certificate-authority"
+		}
+		}
+		x509_config {
+		ca_options {
+			is_ca = true
+		}
+		key_usage {
+			base_key_usage {
+			cert_sign = true
+			crl_sign = true
+			}
+			extended_key_usage {
+			server_auth = false
+			}
+		}
+		}
+	}
+	key_spec {
+		algorithm = "RSA_PKCS1_4096_SHA256"
+	}
+}
+
+resource "google_privateca_certificate_authority" "sub-ca" {
+	pool = "%{pool_name}"
+	certificate_authority_id = "tf-test-my-certificate-authority-%{random_suffix}-sub"
+	location = "%{pool_location}"
+	deletion_protection = false
+	skip_grace_period = true
+	type = "SUBORDINATE"
+	config {
+		subject_config {
+		subject {
+			organization = "HashiCorp"
+			common_name = "my-subordinate-authority"
+		}
+		}
+		x509_config {
+		ca_options {
+			is_ca = true
+			max_issuer_path_length = 0
+		}
+		key_usage {
+			base_key_usage {
+			cert_sign = true
+			crl_sign = true
+			}
+			extended_key_usage {
+			server_auth = false
+			}
+		}
+		}
+	}
+	lifetime = "86400s"
+	key_spec {
+		algorithm = "RSA_PKCS1_4096_SHA256"
+	}
+}
+`, context)
+}
+
+func testAccPrivatecaCertificateAuthority_subordinateCaActivated(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_privateca_certificate_authority" "root-ca" {
+	pool = "%{pool_name}"
+	certificate_authority_id = "tf-test-my-certificate-authority-%{random_suffix}-root"
+	location = "%{pool_location}"
+	deletion_protection = false
+	skip_grace_period = true
+	ignore_active_certificates_on_deletion = true
+	config {
+		subject_config {
+		subject {
+			organization = "HashiCorp"
+			common_name = "my-certificate-authority"
+		}
+		}
+		x509_config {
+		ca_options {
+			is_ca = true
+		}
+		key_usage {
+			base_key_usage {
+			cert_sign = true
+			crl_sign = true
+			}
+			extended_key_usage {
+			server_auth = false
+			}
+		}
+		}
+	}
+	key_spec {
+		algorithm = "RSA_PKCS1_4096_SHA256"
+	}
+}
+
+// Reading the CSR through the data source keeps the sub CA from depending on itself.
+data "google_privateca_certificate_authority" "sub-ca" {
+	pool = "%{pool_name}"
+	certificate_authority_id = "tf-test-my-certificate-authority-%{random_suffix}-sub"
+	location = "%{pool_location}"
+}
+
+// Stands in for the third party issuer signing the CSR out of band.
+resource "google_privateca_certificate" "sub-ca" {
+	pool = "%{pool_name}"
+	location = "%{pool_location}"
+	certificate_authority = google_privateca_certificate_authority.root-ca.certificate_authority_id
+	lifetime = "86400s"
+	name = "tf-test-my-certificate-%{random_suffix}"
+	pem_csr = data.google_privateca_certificate_authority.sub-ca.pem_csr
+
+	lifecycle {
+		// The CSR is cleared once the sub CA is activated.
+		ignore_changes = [pem_csr]
+	}
+}
+
+resource "google_privateca_certificate_authority" "sub-ca" {
+	pool = "%{pool_name}"
+	certificate_authority_id = "tf-test-my-certificate-authority-%{random_suffix}-sub"
+	location = "%{pool_location}"
+	deletion_protection = false
+	skip_grace_period = true
+	type = "SUBORDINATE"
+	pem_ca_certificate = google_privateca_certificate.sub-ca.pem_certificate
+	subordinate_config {
+		pem_issuer_chain {
+			pem_certificates = google_privateca_certificate.sub-ca.pem_certificate_chain
+		}
+	}
+	config {
+		subject_config {
+		subject {
+			organization = "HashiCorp"
+			common_name = "my-subordinate-authority"
+		}
+		}
+		x509_config {
+		ca_options {
+			is_ca = true
+			max_issuer_path_length = 0
+		}
+		key_usage {
+			base_key_usage {
+			cert_sign = true
+			crl_sign = true
+			}
+			extended_key_usage {
+			server_auth = false
+			}
+		}
+		}
+	}
+	lifetime = "86400s"
+	key_spec {
+		algorithm = "RSA_PKCS1_4096_SHA256"
+	}
+}
+`, context)
+}
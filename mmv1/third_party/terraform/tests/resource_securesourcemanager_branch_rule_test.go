@@ -0,0 +1,90 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecuresourcemanagerBranchRule_secureSourceManagerBranchRuleUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecuresourcemanagerBranchRuleDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecuresourcemanagerBranchRule_basic(context),
+			},
+			{
+				ResourceName:            "google_securesourcemanager_branch_rule.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"branch_rule_id", "location", "repository_id"},
+			},
+			{
+				Config: testAccSecuresourcemanagerBranchRule_update(context),
+			},
+			{
+				ResourceName:            "google_securesourcemanager_branch_rule.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"branch_rule_id", "location", "repository_id"},
+			},
+		},
+	})
+}
+
+func testAccSecuresourcemanagerBranchRule_repositoryConfig(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_securesourcemanager_instance" "instance" {
+  location    = "us-central1"
+  instance_id = "tf-test-my-instance%{random_suffix}"
+}
+
+resource "google_securesourcemanager_repository" "repository" {
+  location      = "us-central1"
+  repository_id = "tf-test-my-repository%{random_suffix}"
+  instance      = google_securesourcemanager_instance.instance.name
+}
+`, context)
+}
+
+func testAccSecuresourcemanagerBranchRule_basic(context map[string]interface{}) string {
+	return testAccSecuresourcemanagerBranchRule_repositoryConfig(context) + Nprintf(`
+resource "google_securesourcemanager_branch_rule" "default" {
+  location                = "us-central1"
+  repository_id           = google_securesourcemanager_repository.repository.repository_id
+  branch_rule_id          = "tf-test-my-branchrule%{random_suffix}"
+  include_pattern         = "test"
+  minimum_approvals_count = 2
+  minimum_reviews_count   = 2
+  require_linear_history  = true
+  require_pull_request    = true
+  disabled                = false
+}
+`, context)
+}
+
+func testAccSecuresourcemanagerBranchRule_update(context map[string]interface{}) string {
+	return testAccSecuresourcemanagerBranchRule_repositoryConfig(context) + Nprintf(`
+resource "google_securesourcemanager_branch_rule" "default" {
+  location                  = "us-central1"
+  repository_id             = google_securesourcemanager_repository.repository.repository_id
+  branch_rule_id            = "tf-test-my-branchrule%{random_suffix}"
+  include_pattern           = "release-.*"
+  minimum_approvals_count   = 1
+  minimum_reviews_count     = 1
+  require_comments_resolved = true
+  require_linear_history    = false
+  require_pull_request      = true
+  allow_stale_reviews       = true
+  disabled                  = true
+}
+`, context)
+}
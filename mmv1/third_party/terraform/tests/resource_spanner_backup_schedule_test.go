@@ -0,0 +1,84 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSpannerBackupSchedule_update(t *testing.T) {
+	// Randomness due to spanner instance
+	skipIfVcr(t)
+	t.Parallel()
+
+	rnd := randString(t, 10)
+	instanceName := fmt.Sprintf("tf-test-%s", rnd)
+	databaseName := fmt.Sprintf("tfgen_%s", rnd)
+	scheduleName := fmt.Sprintf("tf-test-%s", rnd)
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSpannerBackupScheduleDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpannerBackupSchedule_update(instanceName, databaseName, scheduleName, "86400s", "0 2 * * *"),
+			},
+			{
+				ResourceName:      "google_spanner_backup_schedule.schedule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// retention_duration and spec are updated in place
+				Config: testAccSpannerBackupSchedule_update(instanceName, databaseName, scheduleName, "604800s", "0 2/12 * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_spanner_backup_schedule.schedule", "retention_duration", "604800s"),
+					resource.TestCheckResourceAttr("google_spanner_backup_schedule.schedule", "spec.0.cron_spec.0.text", "0 2/12 * * *"),
+				),
+			},
+			{
+				ResourceName:      "google_spanner_backup_schedule.schedule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSpannerBackupSchedule_update(instanceName, databaseName, scheduleName, retention, cron string) string {
+	return fmt.Sprintf(`
+resource "google_spanner_instance" "instance" {
+  name         = "%s"
+  config       = "regional-us-central1"
+  display_name = "%s"
+  num_nodes    = 1
+}
+
+resource "google_spanner_database" "database" {
+  instance            = google_spanner_instance.instance.name
+  name                = "%s"
+  deletion_protection = false
+}
+
+resource "google_spanner_backup_schedule" "schedule" {
+  instance = google_spanner_instance.instance.name
+  database = google_spanner_database.database.name
+  name     = "%s"
+
+  retention_duration = "%s"
+
+  spec {
+    cron_spec {
+      text = "%s"
+    }
+  }
+  incremental_backup_spec = true
+
+  encryption_config {
+    encryption_type = "GOOGLE_DEFAULT_ENCRYPTION"
+  }
+}
+`, instanceName, instanceName, databaseName, scheduleName, retention, cron)
+}
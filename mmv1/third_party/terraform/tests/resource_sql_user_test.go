@@ -105,6 +105,46 @@ func TestAccSqlUser_postgres(t *testing.T) {
 	})
 }
 
+func TestAccSqlUser_postgresPasswordWo(t *testing.T) {
+	t.Parallel()
+
+	instance := fmt.Sprintf("tf-test-%d", randInt(t))
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlUserDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleSqlUser_postgresPasswordWo(instance, "password", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlUserExists(t, "google_sql_user.user"),
+					resource.TestCheckResourceAttr("google_sql_user.user", "password_wo", ""),
+				),
+			},
+			{
+				// Changing the write-only password alone doesn't produce a diff
+				Config:   testGoogleSqlUser_postgresPasswordWo(instance, "new_password", 1),
+				PlanOnly: true,
+			},
+			{
+				// Update password
+				Config: testGoogleSqlUser_postgresPasswordWo(instance, "new_password", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlUserExists(t, "google_sql_user.user"),
+					resource.TestCheckResourceAttr("google_sql_user.user", "password_wo", ""),
+				),
+			},
+			{
+				ResourceName:            "google_sql_user.user",
+				ImportStateId:           fmt.Sprintf("%s/%s/admin", getTestProjectFromEnv(), instance),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version"},
+			},
+		},
+	})
+}
+
 func TestAccSqlUser_postgresIAM(t *testing.T) {
 	t.Parallel()
 
@@ -370,6 +410,28 @@ resource "google_sql_user" "user" {
 `, instance, password)
 }
 
+func testGoogleSqlUser_postgresPasswordWo(instance, password string, version int) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "instance" {
+  name             = "%s"
+  region           = "us-central1"
+  database_version = "POSTGRES_9_6"
+  deletion_protection = false
+
+  settings {
+    tier = "db-f1-micro"
+  }
+}
+
+resource "google_sql_user" "user" {
+  name                = "admin"
+  instance            = google_sql_database_instance.instance.name
+  password_wo         = "%s"
+  password_wo_version = %d
+}
+`, instance, password, version)
+}
+
 func testGoogleSqlUser_postgresIAM(instance string) string {
 	return fmt.Sprintf(`
 resource "google_sql_database_instance" "instance" {
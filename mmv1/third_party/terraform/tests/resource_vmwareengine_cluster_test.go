@@ -0,0 +1,115 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVmwareengineCluster_vmwareEngineClusterUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVmwareengineClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVmwareengineCluster_config(context, 3, 28),
+			},
+			{
+				ResourceName:            "google_vmwareengine_cluster.vmw-engine-ext-cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parent", "name"},
+			},
+			{
+				Config: testAccVmwareengineCluster_autoscalingConfig(context, 4, 32),
+			},
+			{
+				ResourceName:            "google_vmwareengine_cluster.vmw-engine-ext-cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parent", "name"},
+			},
+		},
+	})
+}
+
+func testAccVmwareengineCluster_config(context map[string]interface{}, nodeCount, customCoreCount int) string {
+	context["node_count"] = nodeCount
+	context["custom_core_count"] = customCoreCount
+	return testAccVmwareengineCluster_privateCloudConfig(context) + Nprintf(`
+resource "google_vmwareengine_cluster" "vmw-engine-ext-cluster" {
+  name   = "tf-test-ext-cluster%{random_suffix}"
+  parent = google_vmwareengine_private_cloud.cluster-pc.id
+
+  node_type_configs {
+    node_type_id      = "standard-72"
+    node_count        = %{node_count}
+    custom_core_count = %{custom_core_count}
+  }
+}
+`, context)
+}
+
+func testAccVmwareengineCluster_autoscalingConfig(context map[string]interface{}, nodeCount, customCoreCount int) string {
+	context["node_count"] = nodeCount
+	context["custom_core_count"] = customCoreCount
+	return testAccVmwareengineCluster_privateCloudConfig(context) + Nprintf(`
+resource "google_vmwareengine_cluster" "vmw-engine-ext-cluster" {
+  name   = "tf-test-ext-cluster%{random_suffix}"
+  parent = google_vmwareengine_private_cloud.cluster-pc.id
+
+  node_type_configs {
+    node_type_id      = "standard-72"
+    node_count        = %{node_count}
+    custom_core_count = %{custom_core_count}
+  }
+
+  autoscaling_settings {
+    autoscaling_policies {
+      autoscale_policy_id = "autoscaling-policy"
+      node_type_id        = "standard-72"
+      scale_out_size      = 1
+
+      cpu_thresholds {
+        scale_out = 80
+        scale_in  = 15
+      }
+    }
+    min_cluster_node_count = 3
+    max_cluster_node_count = 8
+    cool_down_period       = "1800s"
+  }
+}
+`, context)
+}
+
+func testAccVmwareengineCluster_privateCloudConfig(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_vmwareengine_private_cloud" "cluster-pc" {
+  location    = "us-west1-a"
+  name        = "tf-test-sample-pc%{random_suffix}"
+  description = "Sample test PC."
+
+  network_config {
+    management_cidr       = "192.168.30.0/24"
+    vmware_engine_network = "projects/%{project}/locations/global/vmwareEngineNetworks/pc-nw"
+  }
+
+  management_cluster {
+    cluster_id = "sample-mgmt-cluster"
+    node_type_configs {
+      node_type_id = "standard-72"
+      node_count   = 3
+    }
+  }
+}
+`, context)
+}
@@ -56,3 +56,133 @@ resource "google_compute_network" "custom_test" {
 }
 `, context)
 }
+
+func TestAccVPCAccessConnector_vpcAccessConnectorScalingUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAccessConnectorDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAccessConnector_vpcAccessConnectorScaling(context, "e2-micro", 2, 3),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCAccessConnector_vpcAccessConnectorScaling(context, "e2-standard-4", 3, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_vpc_access_connector.connector", "max_instances", "5"),
+					resource.TestCheckResourceAttrSet("google_vpc_access_connector.connector", "connected_projects.#"),
+				),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCAccessConnector_vpcAccessConnectorScaling(context map[string]interface{}, machineType string, minInstances, maxInstances int) string {
+	context["machine_type"] = machineType
+	context["min_instances"] = minInstances
+	context["max_instances"] = maxInstances
+	return Nprintf(`
+resource "google_vpc_access_connector" "connector" {
+  name          = "tf-test-vpc-con%{random_suffix}"
+  subnet {
+    name = google_compute_subnetwork.custom_test.name
+  }
+  machine_type  = "%{machine_type}"
+  min_instances = %{min_instances}
+  max_instances = %{max_instances}
+  region        = "us-central1"
+}
+
+resource "google_compute_subnetwork" "custom_test" {
+  name          = "tf-test-vpc-con%{random_suffix}"
+  ip_cidr_range = "10.2.0.0/28"
+  region        = "us-central1"
+  network       = google_compute_network.custom_test.id
+}
+
+resource "google_compute_network" "custom_test" {
+  name                    = "tf-test-vpc-con%{random_suffix}"
+  auto_create_subnetworks = false
+}
+`, context)
+}
+
+func TestAccVPCAccessConnector_vpcAccessConnectorThroughputUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAccessConnectorDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAccessConnector_vpcAccessConnectorThroughputUpdate(context, 200, 300),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCAccessConnector_vpcAccessConnectorThroughputUpdate(context, 300, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_vpc_access_connector.connector", "min_throughput", "300"),
+					resource.TestCheckResourceAttr("google_vpc_access_connector.connector", "max_throughput", "500"),
+				),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVPCAccessConnector_vpcAccessConnectorThroughputUpdate(context map[string]interface{}, minThroughput, maxThroughput int) string {
+	context["min_throughput"] = minThroughput
+	context["max_throughput"] = maxThroughput
+	return Nprintf(`
+resource "google_vpc_access_connector" "connector" {
+  name           = "tf-test-vpc-con%{random_suffix}"
+  subnet {
+    name = google_compute_subnetwork.custom_test.name
+  }
+  min_throughput = %{min_throughput}
+  max_throughput = %{max_throughput}
+  region         = "us-central1"
+}
+
+resource "google_compute_subnetwork" "custom_test" {
+  name          = "tf-test-vpc-con%{random_suffix}"
+  ip_cidr_range = "10.2.0.0/28"
+  region        = "us-central1"
+  network       = google_compute_network.custom_test.id
+}
+
+resource "google_compute_network" "custom_test" {
+  name                    = "tf-test-vpc-con%{random_suffix}"
+  auto_create_subnetworks = false
+}
+`, context)
+}
@@ -0,0 +1,194 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccWorkbenchInstance_update(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkbenchInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkbenchInstance_basic(context),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+			{
+				Config: testAccWorkbenchInstance_update(context),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+		},
+	})
+}
+
+func TestAccWorkbenchInstance_thirdPartyIdentity(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkbenchInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkbenchInstance_thirdPartyIdentity(context, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_workbench_instance.instance", "enable_third_party_identity", "true"),
+				),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+			{
+				Config: testAccWorkbenchInstance_thirdPartyIdentity(context, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_workbench_instance.instance", "enable_third_party_identity", "false"),
+				),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+		},
+	})
+}
+
+func TestAccWorkbenchInstance_resizeDisks(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": randString(t, 10),
+	}
+
+	vcrTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkbenchInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkbenchInstance_disks(context, 150, 100),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+			{
+				Config: testAccWorkbenchInstance_disks(context, 200, 150),
+			},
+			{
+				ResourceName:            "google_workbench_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name", "location", "instance_owners"},
+			},
+		},
+	})
+}
+
+func testAccWorkbenchInstance_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_workbench_instance" "instance" {
+  name     = "tf-test-workbench-instance%{random_suffix}"
+  location = "us-central1-a"
+
+  gce_setup {
+    machine_type = "n1-standard-4"
+  }
+
+  labels = {
+    k = "val"
+  }
+}
+`, context)
+}
+
+func testAccWorkbenchInstance_update(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_workbench_instance" "instance" {
+  name     = "tf-test-workbench-instance%{random_suffix}"
+  location = "us-central1-a"
+
+  gce_setup {
+    machine_type = "n1-standard-16"
+
+    metadata = {
+      notebook-upgrade-schedule = "00 19 * * MON"
+    }
+  }
+
+  labels = {
+    k = "val2"
+  }
+}
+`, context)
+}
+
+func testAccWorkbenchInstance_thirdPartyIdentity(context map[string]interface{}, enabled bool) string {
+	context["enable_third_party_identity"] = enabled
+	return Nprintf(`
+resource "google_workbench_instance" "instance" {
+  name     = "tf-test-workbench-instance%{random_suffix}"
+  location = "us-central1-a"
+
+  gce_setup {
+    machine_type = "n1-standard-4"
+  }
+
+  enable_third_party_identity = %{enable_third_party_identity}
+}
+`, context)
+}
+
+func testAccWorkbenchInstance_disks(context map[string]interface{}, bootDiskSize, dataDiskSize int) string {
+	context["boot_disk_size"] = bootDiskSize
+	context["data_disk_size"] = dataDiskSize
+	return Nprintf(`
+resource "google_workbench_instance" "instance" {
+  name     = "tf-test-workbench-instance%{random_suffix}"
+  location = "us-central1-a"
+
+  gce_setup {
+    machine_type = "e2-standard-4"
+
+    boot_disk {
+      disk_size_gb = %{boot_disk_size}
+      disk_type    = "PD_BALANCED"
+    }
+
+    data_disks {
+      disk_size_gb = %{data_disk_size}
+      disk_type    = "PD_BALANCED"
+    }
+  }
+}
+`, context)
+}
@@ -0,0 +1,95 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// aggregatedListItem is an item of an aggregatedList response, along with the
+// scope it was listed in, such as zones/us-central1-a or global.
+type aggregatedListItem struct {
+	Scope string
+	Item  map[string]interface{}
+}
+
+// ScopeName returns the name of the zone or region the item is in, or "" for
+// global items.
+func (i aggregatedListItem) ScopeName() string {
+	if parts := strings.SplitN(i.Scope, "/", 2); len(parts) == 2 {
+		return parts[1]
+	}
+	return ""
+}
+
+// sendAggregatedListRequest reads every page of an aggregatedList endpoint,
+// such as projects/{project}/aggregated/disks, and returns the items found
+// under key in every scope. It fails if a scope is unreachable, as the items
+// would be incomplete.
+func sendAggregatedListRequest(config *Config, billingProject, url, userAgent, key string, params map[string]string, errorRetryPredicates ...RetryErrorPredicateFunc) ([]aggregatedListItem, error) {
+	items := make([]aggregatedListItem, 0)
+	err := listPaginatedItems(config, billingProject, url, userAgent, params, func(res map[string]interface{}) error {
+		pageItems, err := aggregatedListPageItems(res, key)
+		if err != nil {
+			return err
+		}
+		items = append(items, pageItems...)
+		return nil
+	}, errorRetryPredicates...)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// aggregatedListPageItems returns the items of one page of an aggregatedList
+// response, ordered by scope. Scopes without items only hold a
+// NO_RESULTS_ON_PAGE warning, which is skipped. Other warnings are logged.
+func aggregatedListPageItems(res map[string]interface{}, key string) ([]aggregatedListItem, error) {
+	logAggregatedListWarning("page", res["warning"])
+
+	scopes, _ := res["items"].(map[string]interface{})
+	names := make([]string, 0, len(scopes))
+	for scope := range scopes {
+		names = append(names, scope)
+	}
+	sort.Strings(names)
+
+	var unreachable []string
+	if raw, ok := res["unreachables"].([]interface{}); ok {
+		for _, scope := range raw {
+			unreachable = append(unreachable, fmt.Sprintf("%v", scope))
+		}
+	}
+
+	items := make([]aggregatedListItem, 0)
+	for _, scope := range names {
+		scoped, _ := scopes[scope].(map[string]interface{})
+		if warning, ok := scoped["warning"].(map[string]interface{}); ok && warning["code"] == "UNREACHABLE" {
+			unreachable = append(unreachable, scope)
+		} else {
+			logAggregatedListWarning(scope, scoped["warning"])
+		}
+
+		list, _ := scoped[key].([]interface{})
+		for _, raw := range list {
+			if item, ok := raw.(map[string]interface{}); ok {
+				items = append(items, aggregatedListItem{Scope: scope, Item: item})
+			}
+		}
+	}
+
+	if len(unreachable) > 0 {
+		return nil, fmt.Errorf("Error listing %s: scopes are unreachable, so the list would be incomplete: %s", key, strings.Join(unreachable, ", "))
+	}
+	return items, nil
+}
+
+func logAggregatedListWarning(scope string, v interface{}) {
+	warning, ok := v.(map[string]interface{})
+	if !ok || warning["code"] == "NO_RESULTS_ON_PAGE" {
+		return
+	}
+	log.Printf("[WARN] aggregatedList warning for %s: %v: %v", scope, warning["code"], warning["message"])
+}
@@ -0,0 +1,67 @@
+package google
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAggregatedListPageItems(t *testing.T) {
+	res := map[string]interface{}{
+		"items": map[string]interface{}{
+			"zones/us-central1-b": map[string]interface{}{
+				"disks": []interface{}{
+					map[string]interface{}{"name": "disk-2"},
+				},
+			},
+			"zones/us-central1-a": map[string]interface{}{
+				"disks": []interface{}{
+					map[string]interface{}{"name": "disk-1"},
+				},
+			},
+			"zones/us-east1-b": map[string]interface{}{
+				"warning": map[string]interface{}{
+					"code":    "NO_RESULTS_ON_PAGE",
+					"message": "There are no results for scope 'zones/us-east1-b' on this page.",
+				},
+			},
+		},
+	}
+
+	items, err := aggregatedListPageItems(res, "disks")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", items)
+	}
+	if items[0].Scope != "zones/us-central1-a" || items[0].ScopeName() != "us-central1-a" || items[0].Item["name"] != "disk-1" {
+		t.Errorf("unexpected first item %v", items[0])
+	}
+	if items[1].ScopeName() != "us-central1-b" || items[1].Item["name"] != "disk-2" {
+		t.Errorf("unexpected second item %v", items[1])
+	}
+	if scope := (aggregatedListItem{Scope: "global"}).ScopeName(); scope != "" {
+		t.Errorf("expected no scope name for global items, got %q", scope)
+	}
+}
+
+func TestAggregatedListPageItems_unreachable(t *testing.T) {
+	res := map[string]interface{}{
+		"items": map[string]interface{}{
+			"zones/us-central1-a": map[string]interface{}{
+				"warning": map[string]interface{}{
+					"code": "UNREACHABLE",
+				},
+			},
+		},
+		"unreachables": []interface{}{"zones/us-central1-b"},
+	}
+
+	_, err := aggregatedListPageItems(res, "disks")
+	if err == nil {
+		t.Fatal("expected an error for unreachable scopes")
+	}
+	if !strings.Contains(err.Error(), "zones/us-central1-a") || !strings.Contains(err.Error(), "zones/us-central1-b") {
+		t.Errorf("expected both unreachable scopes in %q", err)
+	}
+}
@@ -0,0 +1,151 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Names of the opt-in checks accepted by the provider level
+// `enforce_best_practices` field.
+const (
+	bestPracticeNoPublicBuckets    = "no-public-buckets"
+	bestPracticeShieldedVmRequired = "shielded-vm-required"
+)
+
+// bestPracticeValidators maps each opt-in check to the plan-time validators it
+// installs, keyed by the name of the resource they are attached to. Validators
+// only run when the check is listed in the provider configuration.
+var bestPracticeValidators = map[string]map[string]func(TerraformResourceDiff) error{
+	bestPracticeNoPublicBuckets: {
+		"google_storage_bucket_iam_member":             bestPracticeNoPublicBucketIamMemberFunc,
+		"google_storage_bucket_iam_binding":            bestPracticeNoPublicBucketIamBindingFunc,
+		"google_storage_bucket_acl":                    bestPracticeNoPublicBucketAclFunc,
+		"google_storage_bucket_access_control":         bestPracticeNoPublicBucketAccessControlFunc,
+		"google_storage_default_object_access_control": bestPracticeNoPublicBucketAccessControlFunc,
+	},
+	bestPracticeShieldedVmRequired: {
+		"google_compute_instance":          bestPracticeShieldedVmRequiredFunc,
+		"google_compute_instance_template": bestPracticeShieldedVmRequiredFunc,
+	},
+}
+
+// publicPrincipals are the IAM principals and ACL entities that grant access to
+// anyone on the internet.
+var publicPrincipals = []string{"allUsers", "allAuthenticatedUsers"}
+
+var publicPredefinedAcls = []string{"publicRead", "publicReadWrite"}
+
+func bestPracticeNames() []string {
+	names := make([]string, 0, len(bestPracticeValidators))
+	for name := range bestPracticeValidators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Config) bestPracticeEnforced(name string) bool {
+	return stringInSlice(c.EnforceBestPractices, name)
+}
+
+// addBestPracticeValidators attaches the validators in bestPracticeValidators to
+// the matching resources, preserving any CustomizeDiff they already define.
+func addBestPracticeValidators(resources map[string]*schema.Resource) {
+	for _, name := range bestPracticeNames() {
+		for resourceName, validator := range bestPracticeValidators[name] {
+			r, ok := resources[resourceName]
+			if !ok {
+				continue
+			}
+
+			check := bestPracticeCustomizeDiff(name, validator)
+			if r.CustomizeDiff == nil {
+				r.CustomizeDiff = check
+			} else {
+				r.CustomizeDiff = customdiff.All(r.CustomizeDiff, check)
+			}
+		}
+	}
+}
+
+func bestPracticeCustomizeDiff(name string, validator func(TerraformResourceDiff) error) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		config, ok := meta.(*Config)
+		if !ok || !config.bestPracticeEnforced(name) {
+			return nil
+		}
+		if err := validator(diff); err != nil {
+			return fmt.Errorf("%s (enforced by the %q best practice)", err, name)
+		}
+		return nil
+	}
+}
+
+func isPublicPrincipal(member string) bool {
+	// Entities in ACLs may be prefixed with a role, e.g. "READER:allUsers"
+	if parts := strings.Split(member, ":"); len(parts) == 2 {
+		member = parts[1]
+	}
+	return stringInSlice(publicPrincipals, member)
+}
+
+func bestPracticeNoPublicBucketIamMemberFunc(diff TerraformResourceDiff) error {
+	if member, ok := diff.Get("member").(string); ok && isPublicPrincipal(member) {
+		return fmt.Errorf("member %q grants public access to the bucket", member)
+	}
+	return nil
+}
+
+func bestPracticeNoPublicBucketIamBindingFunc(diff TerraformResourceDiff) error {
+	members, ok := diff.Get("members").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	for _, m := range members.List() {
+		if member := m.(string); isPublicPrincipal(member) {
+			return fmt.Errorf("member %q grants public access to the bucket", member)
+		}
+	}
+	return nil
+}
+
+func bestPracticeNoPublicBucketAclFunc(diff TerraformResourceDiff) error {
+	for _, field := range []string{"predefined_acl", "default_acl"} {
+		if acl, ok := diff.Get(field).(string); ok && stringInSlice(publicPredefinedAcls, acl) {
+			return fmt.Errorf("%s %q grants public access to the bucket", field, acl)
+		}
+	}
+
+	roleEntities, ok := diff.Get("role_entity").([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, re := range roleEntities {
+		if roleEntity, ok := re.(string); ok && isPublicPrincipal(roleEntity) {
+			return fmt.Errorf("role_entity %q grants public access to the bucket", roleEntity)
+		}
+	}
+	return nil
+}
+
+func bestPracticeNoPublicBucketAccessControlFunc(diff TerraformResourceDiff) error {
+	if entity, ok := diff.Get("entity").(string); ok && isPublicPrincipal(entity) {
+		return fmt.Errorf("entity %q grants public access to the bucket", entity)
+	}
+	return nil
+}
+
+func bestPracticeShieldedVmRequiredFunc(diff TerraformResourceDiff) error {
+	for _, field := range []string{"enable_secure_boot", "enable_vtpm", "enable_integrity_monitoring"} {
+		key := "shielded_instance_config.0." + field
+		if enabled, ok := diff.Get(key).(bool); !ok || !enabled {
+			return fmt.Errorf("%s must be set to true", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package google
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBestPracticeNoPublicBucketFuncs(t *testing.T) {
+	cases := map[string]struct {
+		Validator   func(TerraformResourceDiff) error
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"iam member private": {
+			Validator: bestPracticeNoPublicBucketIamMemberFunc,
+			After:     map[string]interface{}{"member": "user:admin@example.com"},
+		},
+		"iam member allUsers": {
+			Validator:   bestPracticeNoPublicBucketIamMemberFunc,
+			After:       map[string]interface{}{"member": "allUsers"},
+			ExpectError: true,
+		},
+		"iam binding allAuthenticatedUsers": {
+			Validator: bestPracticeNoPublicBucketIamBindingFunc,
+			After: map[string]interface{}{
+				"members": schema.NewSet(schema.HashString, []interface{}{"user:admin@example.com", "allAuthenticatedUsers"}),
+			},
+			ExpectError: true,
+		},
+		"iam binding private": {
+			Validator: bestPracticeNoPublicBucketIamBindingFunc,
+			After: map[string]interface{}{
+				"members": schema.NewSet(schema.HashString, []interface{}{"user:admin@example.com"}),
+			},
+		},
+		"acl predefined private": {
+			Validator: bestPracticeNoPublicBucketAclFunc,
+			After:     map[string]interface{}{"predefined_acl": "projectPrivate"},
+		},
+		"acl predefined public": {
+			Validator:   bestPracticeNoPublicBucketAclFunc,
+			After:       map[string]interface{}{"predefined_acl": "publicRead"},
+			ExpectError: true,
+		},
+		"acl default public": {
+			Validator:   bestPracticeNoPublicBucketAclFunc,
+			After:       map[string]interface{}{"default_acl": "publicReadWrite"},
+			ExpectError: true,
+		},
+		"acl role entity public": {
+			Validator: bestPracticeNoPublicBucketAclFunc,
+			After: map[string]interface{}{
+				"role_entity": []interface{}{"OWNER:user-admin@example.com", "READER:allUsers"},
+			},
+			ExpectError: true,
+		},
+		"access control public": {
+			Validator:   bestPracticeNoPublicBucketAccessControlFunc,
+			After:       map[string]interface{}{"entity": "allAuthenticatedUsers"},
+			ExpectError: true,
+		},
+		"access control private": {
+			Validator: bestPracticeNoPublicBucketAccessControlFunc,
+			After:     map[string]interface{}{"entity": "group-admins@example.com"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{After: tc.After}
+		err := tc.Validator(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestBestPracticeShieldedVmRequiredFunc(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"all enabled": {
+			After: map[string]interface{}{
+				"shielded_instance_config.0.enable_secure_boot":          true,
+				"shielded_instance_config.0.enable_vtpm":                 true,
+				"shielded_instance_config.0.enable_integrity_monitoring": true,
+			},
+		},
+		"secure boot disabled": {
+			After: map[string]interface{}{
+				"shielded_instance_config.0.enable_secure_boot":          false,
+				"shielded_instance_config.0.enable_vtpm":                 true,
+				"shielded_instance_config.0.enable_integrity_monitoring": true,
+			},
+			ExpectError: true,
+		},
+		"block unset": {
+			After:       map[string]interface{}{},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{After: tc.After}
+		err := bestPracticeShieldedVmRequiredFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAddBestPracticeValidators(t *testing.T) {
+	existing := func(_ context.Context, _ *schema.ResourceDiff, _ interface{}) error { return nil }
+	resources := map[string]*schema.Resource{
+		"google_compute_instance":          {CustomizeDiff: existing},
+		"google_storage_bucket_iam_member": {},
+		"google_compute_network":           {},
+	}
+
+	addBestPracticeValidators(resources)
+
+	for _, name := range []string{"google_compute_instance", "google_storage_bucket_iam_member"} {
+		if resources[name].CustomizeDiff == nil {
+			t.Errorf("expected a CustomizeDiff on %s", name)
+		}
+	}
+	if resources["google_compute_network"].CustomizeDiff != nil {
+		t.Errorf("expected no CustomizeDiff on google_compute_network")
+	}
+}
@@ -0,0 +1,85 @@
+package google
+
+import "sort"
+
+// cloudIdentityMembershipRolesByName returns the expire time of each role of
+// a membership, keyed by role name, from roles in the schema format of
+// google_cloud_identity_group_membership. Roles that don't expire map to "".
+func cloudIdentityMembershipRolesByName(roles []interface{}) map[string]string {
+	byName := make(map[string]string)
+	for _, r := range roles {
+		role := r.(map[string]interface{})
+		expireTime := ""
+		if details, ok := role["expiry_detail"].([]interface{}); ok && len(details) > 0 && details[0] != nil {
+			expireTime = details[0].(map[string]interface{})["expire_time"].(string)
+		}
+		byName[role["name"].(string)] = expireTime
+	}
+	return byName
+}
+
+// cloudIdentityModifyMembershipRolesRequests returns the bodies of the
+// modifyMembershipRoles requests changing the roles of a membership from
+// before to after, both keyed by role name with their expire time. Roles are
+// matched by name, so a role whose expiry changed is updated in place rather
+// than removed and added again. The API doesn't update roles in the same
+// request that adds or removes roles, so roles are added and removed first
+// and updated in a second request. It returns no requests if the roles are
+// unchanged.
+func cloudIdentityModifyMembershipRolesRequests(before, after map[string]string) []map[string]interface{} {
+	addRoles := []interface{}{}
+	removeRoles := []interface{}{}
+	updateRolesParams := []interface{}{}
+
+	for _, name := range cloudIdentitySortedRoleNames(after) {
+		expireTime := after[name]
+		beforeExpireTime, ok := before[name]
+		if !ok {
+			addRoles = append(addRoles, cloudIdentityMembershipRole(name, expireTime))
+		} else if beforeExpireTime != expireTime {
+			updateRolesParams = append(updateRolesParams, map[string]interface{}{
+				"fieldMask":      "expiryDetail.expire_time",
+				"membershipRole": cloudIdentityMembershipRole(name, expireTime),
+			})
+		}
+	}
+	for _, name := range cloudIdentitySortedRoleNames(before) {
+		if _, ok := after[name]; !ok {
+			removeRoles = append(removeRoles, name)
+		}
+	}
+
+	var reqs []map[string]interface{}
+	if len(addRoles)+len(removeRoles) > 0 {
+		reqs = append(reqs, map[string]interface{}{
+			"addRoles":    addRoles,
+			"removeRoles": removeRoles,
+		})
+	}
+	if len(updateRolesParams) > 0 {
+		reqs = append(reqs, map[string]interface{}{
+			"updateRolesParams": updateRolesParams,
+		})
+	}
+	return reqs
+}
+
+// cloudIdentityMembershipRole returns the API representation of a role.
+func cloudIdentityMembershipRole(name, expireTime string) map[string]interface{} {
+	role := map[string]interface{}{"name": name}
+	if expireTime != "" {
+		role["expiryDetail"] = map[string]interface{}{"expireTime": expireTime}
+	}
+	return role
+}
+
+// cloudIdentitySortedRoleNames returns the role names of a map keyed by role
+// name in a stable order.
+func cloudIdentitySortedRoleNames(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,112 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCloudIdentityModifyMembershipRolesRequests(t *testing.T) {
+	cases := map[string]struct {
+		Before           map[string]string
+		After            map[string]string
+		ExpectedRequests []map[string]interface{}
+	}{
+		"unchanged": {
+			Before: map[string]string{"MEMBER": "2030-01-01T00:00:00Z", "OWNER": ""},
+			After:  map[string]string{"MEMBER": "2030-01-01T00:00:00Z", "OWNER": ""},
+		},
+		"roles added and removed": {
+			Before: map[string]string{"MEMBER": "", "OWNER": ""},
+			After:  map[string]string{"MEMBER": "", "MANAGER": ""},
+			ExpectedRequests: []map[string]interface{}{
+				{
+					"addRoles": []interface{}{
+						map[string]interface{}{"name": "MANAGER"},
+					},
+					"removeRoles": []interface{}{"OWNER"},
+				},
+			},
+		},
+		"expiry set": {
+			Before: map[string]string{"MEMBER": ""},
+			After:  map[string]string{"MEMBER": "2030-01-01T00:00:00Z"},
+			ExpectedRequests: []map[string]interface{}{
+				{
+					"updateRolesParams": []interface{}{
+						map[string]interface{}{
+							"fieldMask": "expiryDetail.expire_time",
+							"membershipRole": map[string]interface{}{
+								"name":         "MEMBER",
+								"expiryDetail": map[string]interface{}{"expireTime": "2030-01-01T00:00:00Z"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"expiry removed": {
+			Before: map[string]string{"MEMBER": "2030-01-01T00:00:00Z"},
+			After:  map[string]string{"MEMBER": ""},
+			ExpectedRequests: []map[string]interface{}{
+				{
+					"updateRolesParams": []interface{}{
+						map[string]interface{}{
+							"fieldMask":      "expiryDetail.expire_time",
+							"membershipRole": map[string]interface{}{"name": "MEMBER"},
+						},
+					},
+				},
+			},
+		},
+		"role added and expiry set": {
+			Before: map[string]string{"MEMBER": ""},
+			After:  map[string]string{"MEMBER": "2030-01-01T00:00:00Z", "MANAGER": ""},
+			ExpectedRequests: []map[string]interface{}{
+				{
+					"addRoles": []interface{}{
+						map[string]interface{}{"name": "MANAGER"},
+					},
+					"removeRoles": []interface{}{},
+				},
+				{
+					"updateRolesParams": []interface{}{
+						map[string]interface{}{
+							"fieldMask": "expiryDetail.expire_time",
+							"membershipRole": map[string]interface{}{
+								"name":         "MEMBER",
+								"expiryDetail": map[string]interface{}{"expireTime": "2030-01-01T00:00:00Z"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		reqs := cloudIdentityModifyMembershipRolesRequests(tc.Before, tc.After)
+		if !reflect.DeepEqual(reqs, tc.ExpectedRequests) {
+			t.Errorf("%s: expected requests %#v, got %#v", tn, tc.ExpectedRequests, reqs)
+		}
+	}
+}
+
+func TestCloudIdentityMembershipRolesByName(t *testing.T) {
+	roles := []interface{}{
+		map[string]interface{}{
+			"name":          "OWNER",
+			"expiry_detail": []interface{}{},
+		},
+		map[string]interface{}{
+			"name": "MEMBER",
+			"expiry_detail": []interface{}{
+				map[string]interface{}{"expire_time": "2030-01-01T00:00:00Z"},
+			},
+		},
+	}
+
+	expected := map[string]string{"OWNER": "", "MEMBER": "2030-01-01T00:00:00Z"}
+	if got := cloudIdentityMembershipRolesByName(roles); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
@@ -152,3 +153,69 @@ func PollCheckForAbsence(_ map[string]interface{}, respErr error) PollResult {
 	}
 	return PendingStatusPollResult("found")
 }
+
+// PollCheckForFieldValue returns a PollCheckResponseFunc that waits for the value at path in the
+// response, such as "state", to be one of doneValues. It fails when the value is one of failedValues,
+// and continues polling on any other value. Nested fields are separated by dots.
+func PollCheckForFieldValue(path string, doneValues, failedValues []string) PollCheckResponseFunc {
+	return func(resp map[string]interface{}, respErr error) PollResult {
+		if respErr != nil {
+			return ErrorPollResult(respErr)
+		}
+
+		value, _ := pollResponseField(resp, path).(string)
+		for _, v := range doneValues {
+			if value == v {
+				return SuccessPollResult()
+			}
+		}
+		for _, v := range failedValues {
+			if value == v {
+				return ErrorPollResult(fmt.Errorf("resource %v is in failed state %s %q", resp["name"], path, value))
+			}
+		}
+		return PendingStatusPollResult(value)
+	}
+}
+
+// PollCheckForCondition returns a PollCheckResponseFunc that waits for the condition of type conditionType
+// in the conditions array at path, such as "status.conditions", to have the status "True". It fails when
+// the condition has the status "False", and continues polling while it's missing or "Unknown".
+func PollCheckForCondition(path, conditionType string) PollCheckResponseFunc {
+	return func(resp map[string]interface{}, respErr error) PollResult {
+		if respErr != nil {
+			return ErrorPollResult(respErr)
+		}
+
+		conditions, _ := pollResponseField(resp, path).([]interface{})
+		for _, raw := range conditions {
+			condition, ok := raw.(map[string]interface{})
+			if !ok || condition["type"] != conditionType {
+				continue
+			}
+			log.Printf("[DEBUG] checking %s condition %v: %v", conditionType, condition["status"], condition["message"])
+			switch condition["status"] {
+			case "True":
+				return SuccessPollResult()
+			case "False":
+				return ErrorPollResult(fmt.Errorf(`resource %v is in failed state "%s:False", message: %v`, resp["name"], conditionType, condition["message"]))
+			default:
+				return PendingStatusPollResult(fmt.Sprintf("%v:%v", condition["status"], condition["message"]))
+			}
+		}
+		return PendingStatusPollResult(fmt.Sprintf("no %s condition yet", conditionType))
+	}
+}
+
+// pollResponseField returns the value at a dot-separated path in a poll response, or nil.
+func pollResponseField(resp map[string]interface{}, path string) interface{} {
+	var value interface{} = resp
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
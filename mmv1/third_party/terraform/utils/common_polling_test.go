@@ -0,0 +1,63 @@
+package google
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPollCheckForFieldValue(t *testing.T) {
+	t.Parallel()
+
+	check := PollCheckForFieldValue("status.state", []string{"READY"}, []string{"ERROR"})
+	resp := func(state string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":   "instance-1",
+			"status": map[string]interface{}{"state": state},
+		}
+	}
+
+	if result := check(resp("READY"), nil); result != nil {
+		t.Errorf("expected success for READY, got %v", result.Err)
+	}
+	if result := check(resp("CREATING"), nil); result == nil || !result.Retryable {
+		t.Errorf("expected a pending result for CREATING, got %v", result)
+	}
+	if result := check(map[string]interface{}{}, nil); result == nil || !result.Retryable {
+		t.Errorf("expected a pending result without state, got %v", result)
+	}
+	if result := check(resp("ERROR"), nil); result == nil || result.Retryable {
+		t.Errorf("expected an error for ERROR, got %v", result)
+	}
+	if result := check(nil, errors.New("boom")); result == nil || result.Retryable {
+		t.Errorf("expected an error for a failed read, got %v", result)
+	}
+}
+
+func TestPollCheckForCondition(t *testing.T) {
+	t.Parallel()
+
+	check := PollCheckForCondition("status.conditions", "Ready")
+	resp := func(status string) map[string]interface{} {
+		return map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "RoutesReady", "status": "False"},
+					map[string]interface{}{"type": "Ready", "status": status, "message": "details"},
+				},
+			},
+		}
+	}
+
+	if result := check(resp("True"), nil); result != nil {
+		t.Errorf("expected success for True, got %v", result.Err)
+	}
+	if result := check(resp("Unknown"), nil); result == nil || !result.Retryable {
+		t.Errorf("expected a pending result for Unknown, got %v", result)
+	}
+	if result := check(map[string]interface{}{}, nil); result == nil || !result.Retryable {
+		t.Errorf("expected a pending result without conditions, got %v", result)
+	}
+	if result := check(resp("False"), nil); result == nil || result.Retryable {
+		t.Errorf("expected an error for False, got %v", result)
+	}
+}
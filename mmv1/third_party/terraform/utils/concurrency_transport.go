@@ -0,0 +1,78 @@
+package google
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// concurrencyTransport limits the number of requests that are in flight at
+// the same time against a single API service. Services are identified by the
+// first label of the request host, e.g. "compute" for compute.googleapis.com.
+// Services without a configured limit are not throttled.
+type concurrencyTransport struct {
+	limits      map[string]int
+	baseTransit http.RoundTripper
+
+	mu         sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func newTransportWithConcurrencyLimits(baseTransit http.RoundTripper, limits map[string]int) *concurrencyTransport {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+
+	return &concurrencyTransport{
+		limits:      limits,
+		baseTransit: baseTransit,
+		semaphores:  make(map[string]chan struct{}),
+	}
+}
+
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sem := t.semaphore(serviceFromHost(req.URL.Hostname()))
+	if sem == nil {
+		return t.baseTransit.RoundTrip(req)
+	}
+
+	select {
+	case sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-sem }()
+
+	return t.baseTransit.RoundTrip(req)
+}
+
+// semaphore returns the semaphore for a service, or nil if the service has no
+// concurrency limit.
+func (t *concurrencyTransport) semaphore(service string) chan struct{} {
+	limit, ok := t.limits[service]
+	if !ok || limit <= 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sem, ok := t.semaphores[service]
+	if !ok {
+		log.Printf("[DEBUG] Limiting concurrent requests to the %s API to %d", service, limit)
+		sem = make(chan struct{}, limit)
+		t.semaphores[service] = sem
+	}
+	return sem
+}
+
+// serviceFromHost returns the API service name for a host, stripping the
+// regional prefix of regional endpoints, e.g. "aiplatform" for
+// us-central1-aiplatform.googleapis.com.
+func serviceFromHost(host string) string {
+	service := strings.SplitN(host, ".", 2)[0]
+	if i := strings.LastIndex(service, "-"); i >= 0 {
+		service = service[i+1:]
+	}
+	return strings.ToLower(service)
+}
@@ -0,0 +1,76 @@
+package google
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServiceFromHost(t *testing.T) {
+	cases := map[string]string{
+		"compute.googleapis.com":                "compute",
+		"compute.mtls.googleapis.com":           "compute",
+		"us-central1-aiplatform.googleapis.com": "aiplatform",
+		"Container.googleapis.com":              "container",
+		"localhost":                             "localhost",
+	}
+
+	for host, expected := range cases {
+		if got := serviceFromHost(host); got != expected {
+			t.Errorf("expected service %q for host %q, got %q", expected, host, got)
+		}
+	}
+}
+
+func TestConcurrencyTransport_limitsInFlightRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// The test server listens on 127.0.0.1, which resolves to the service "127".
+	client := ts.Client()
+	client.Transport = newTransportWithConcurrencyLimits(http.DefaultTransport, map[string]int{"127": 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(ts.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestConcurrencyTransport_unlimitedService(t *testing.T) {
+	transport := newTransportWithConcurrencyLimits(http.DefaultTransport, map[string]int{"compute": 1})
+	if sem := transport.semaphore("container"); sem != nil {
+		t.Errorf("expected no semaphore for a service without a limit")
+	}
+	if sem := transport.semaphore("compute"); cap(sem) != 1 {
+		t.Errorf("expected a semaphore of size 1 for compute, got %d", cap(sem))
+	}
+}
@@ -0,0 +1,24 @@
+package google
+
+import "fmt"
+
+// PollCheckDocumentAIProcessorVersionUndeployed waits for a processor version to
+// finish undeploying. Undeploying doesn't delete the version, so it is still
+// returned by the API afterwards.
+func PollCheckDocumentAIProcessorVersionUndeployed(resp map[string]interface{}, respErr error) PollResult {
+	if respErr != nil {
+		if isGoogleApiErrorWithCode(respErr, 404) {
+			return SuccessPollResult()
+		}
+		return ErrorPollResult(respErr)
+	}
+
+	switch state, _ := resp["state"].(string); state {
+	case "UNDEPLOYED":
+		return SuccessPollResult()
+	case "FAILED":
+		return ErrorPollResult(fmt.Errorf("Error undeploying processor version %v", resp["name"]))
+	default:
+		return PendingStatusPollResult(state)
+	}
+}
@@ -50,6 +50,10 @@ var defaultErrorRetryPredicates = []RetryErrorPredicateFunc{
 	// GCE returns the wrong error code, as this should be a 429, which we retry
 	// already.
 	is403QuotaExceededPerMinuteError,
+
+	// Structured error details identify rate limits more reliably than the
+	// error message, which varies between APIs.
+	is403RateLimitErrorDetails,
 }
 
 /** END GLOBAL ERROR RETRY PREDICATES HERE **/
@@ -147,6 +151,38 @@ func is403QuotaExceededPerMinuteError(err error) (bool, string) {
 	return false, ""
 }
 
+// Some APIs return a 403 rather than a 429 on rate limits, but describe the
+// limit in the structured error details. Rate limits refresh, so retry them.
+// Other quota failures, like per-day limits, won't refresh in time to retry.
+func is403RateLimitErrorDetails(err error) (bool, string) {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok || gerr.Code != 403 {
+		return false, ""
+	}
+	for _, d := range gerr.Details {
+		detail, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch detail["@type"] {
+		case "type.googleapis.com/google.rpc.ErrorInfo":
+			if detail["reason"] == "RATE_LIMIT_EXCEEDED" {
+				return true, "Waiting for rate limit to refresh"
+			}
+		case "type.googleapis.com/google.rpc.QuotaFailure":
+			violations, _ := detail["violations"].([]interface{})
+			for _, v := range violations {
+				violation, _ := v.(map[string]interface{})
+				description, _ := violation["description"].(string)
+				if strings.Contains(strings.ToLower(description), "per minute") {
+					return true, fmt.Sprintf("Waiting for quota limit to refresh: %s", description)
+				}
+			}
+		}
+	}
+	return false, ""
+}
+
 // Retry on comon googleapi error codes for retryable errors.
 // TODO(#5609): This may not need to be applied globally - figure out
 // what retryable error codes apply to which API.
@@ -187,6 +223,30 @@ func isFingerprintError(err error) (bool, string) {
 	return false, ""
 }
 
+// Whether a request was rejected because the etag or fingerprint it was sent
+// with is stale, as the resource was modified since it was read. A 412 is
+// always treated as stale, while a 409 only is if its message mentions the
+// etag or fingerprint, as 409s are also returned for other conflicts such as
+// the resource already existing. Only use it for requests that set an etag or
+// fingerprint.
+func isStaleFingerprintError(err error) (bool, string) {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false, ""
+	}
+
+	if gerr.Code == 412 {
+		return true, "precondition failed"
+	}
+
+	msg := strings.ToLower(gerr.Error())
+	if gerr.Code == 409 && (strings.Contains(msg, "etag") || strings.Contains(msg, "fingerprint")) {
+		return true, "etag or fingerprint mismatch"
+	}
+
+	return false, ""
+}
+
 // If a permission necessary to provision a resource is created in the same config
 // as the resource itself, the permission may not have propagated by the time terraform
 // attempts to create the resource. This allows those errors to be retried until the timeout expires
@@ -116,6 +116,98 @@ func TestIs403QuotaExceededPerMinuteError_perDayQuotaExceededNotRetryable(t *tes
 	}
 }
 
+func TestIs403RateLimitErrorDetails_rateLimitExceeded(t *testing.T) {
+	err := googleapi.Error{
+		Code: 403,
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "RATE_LIMIT_EXCEEDED",
+				"domain": "googleapis.com",
+			},
+		},
+	}
+	isRetryable, _ := is403RateLimitErrorDetails(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIs403RateLimitErrorDetails_perMinuteQuotaFailure(t *testing.T) {
+	err := googleapi.Error{
+		Code: 403,
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+				"violations": []interface{}{
+					map[string]interface{}{
+						"subject":     "project_number:11111111",
+						"description": "Write requests per minute per project",
+					},
+				},
+			},
+		},
+	}
+	isRetryable, _ := is403RateLimitErrorDetails(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIs403RateLimitErrorDetails_perDayQuotaFailureNotRetryable(t *testing.T) {
+	err := googleapi.Error{
+		Code: 403,
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+				"violations": []interface{}{
+					map[string]interface{}{
+						"subject":     "project_number:11111111",
+						"description": "Write requests per day per project",
+					},
+				},
+			},
+		},
+	}
+	isRetryable, _ := is403RateLimitErrorDetails(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
+
+func TestIsStaleFingerprintError_preconditionFailed(t *testing.T) {
+	err := googleapi.Error{
+		Code: 412,
+		Body: "Supplied fingerprint does not match current metadata fingerprint.",
+	}
+	isRetryable, _ := isStaleFingerprintError(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsStaleFingerprintError_etagConflict(t *testing.T) {
+	err := googleapi.Error{
+		Code:    409,
+		Message: "The etag provided does not match the current etag of the resource.",
+	}
+	isRetryable, _ := isStaleFingerprintError(&err)
+	if !isRetryable {
+		t.Errorf("Error not detected as retryable")
+	}
+}
+
+func TestIsStaleFingerprintError_otherConflictNotRetryable(t *testing.T) {
+	err := googleapi.Error{
+		Code:    409,
+		Message: "The resource already exists.",
+	}
+	isRetryable, _ := isStaleFingerprintError(&err)
+	if isRetryable {
+		t.Errorf("Error incorrectly detected as retryable")
+	}
+}
+
 // An error with retry info is retryable.
 func TestBigtableError_retryable(t *testing.T) {
 	retryInfo := &errdetails.RetryInfo{
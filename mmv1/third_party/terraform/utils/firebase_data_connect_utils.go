@@ -0,0 +1,39 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func firebaseDataConnectSourceFilesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return firebaseDataConnectSourceFilesCustomizeDiffFunc(diff)
+}
+
+// The source files of a schema or a connector are deployed as a workspace, so
+// their paths must be relative and can't collide with each other.
+func firebaseDataConnectSourceFilesCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	count, ok := diff.Get("source.0.files.#").(int)
+	if !ok {
+		return nil
+	}
+
+	paths := make(map[string]bool, count)
+	for i := 0; i < count; i++ {
+		path, _ := diff.Get(fmt.Sprintf("source.0.files.%d.path", i)).(string)
+		if path == "" {
+			// Unknown until apply
+			continue
+		}
+		if strings.HasPrefix(path, "/") {
+			return fmt.Errorf("source file path %q must be relative to the workspace", path)
+		}
+		if paths[path] {
+			return fmt.Errorf("source file path %q is used by more than one file", path)
+		}
+		paths[path] = true
+	}
+	return nil
+}
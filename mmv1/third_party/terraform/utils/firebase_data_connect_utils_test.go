@@ -0,0 +1,53 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestFirebaseDataConnectSourceFilesCustomizeDiffFunc(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"unique relative paths": {
+			After: map[string]interface{}{
+				"source.0.files.#":      2,
+				"source.0.files.0.path": "schema/schema.gql",
+				"source.0.files.1.path": "schema/types.gql",
+			},
+		},
+		"duplicate paths": {
+			After: map[string]interface{}{
+				"source.0.files.#":      2,
+				"source.0.files.0.path": "schema/schema.gql",
+				"source.0.files.1.path": "schema/schema.gql",
+			},
+			ExpectError: true,
+		},
+		"absolute path": {
+			After: map[string]interface{}{
+				"source.0.files.#":      1,
+				"source.0.files.0.path": "/workspace/schema/schema.gql",
+			},
+			ExpectError: true,
+		},
+		"unknown path": {
+			After: map[string]interface{}{
+				"source.0.files.#":      2,
+				"source.0.files.0.path": "",
+				"source.0.files.1.path": "",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{After: tc.After}
+		err := firebaseDataConnectSourceFilesCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
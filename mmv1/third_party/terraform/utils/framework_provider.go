@@ -0,0 +1,114 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProtoV5ProviderServerFactory returns a factory of the provider server that
+// serves the resources of the SDK provider together with the resources
+// generated as plugin-framework resources.
+func ProtoV5ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	frameworkServer := providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider})
+
+	// The mux server configures its servers in order, so the SDK provider is
+	// configured before the framework provider reads its *Config.
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return &frameworkProviderServer{
+				ProviderServer: frameworkServer(),
+				sdkServer:      sdkProvider.GRPCProvider(),
+			}
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating the provider server: %s", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider is the plugin-framework provider of the generated
+// plugin-framework resources. It has no configuration of its own and passes
+// the *Config of the SDK provider to its resources as provider data.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "google"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	config, ok := p.sdkProvider.Meta().(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before its plugin-framework resources are used.")
+		return
+	}
+	resp.ResourceData = config
+}
+
+func (p *frameworkProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(context.Context) []func() resource.Resource {
+	return frameworkGeneratedResources()
+}
+
+// frameworkProviderServer adapts the server of the framework provider to be
+// muxed with the SDK provider, which requires both to have the same provider
+// schema. It reports the provider schema of the SDK provider, and leaves
+// validating and decoding the provider configuration to the SDK provider.
+type frameworkProviderServer struct {
+	tfprotov5.ProviderServer
+	sdkServer tfprotov5.ProviderServer
+}
+
+func (s *frameworkProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	sdkResp, err := s.sdkServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.Provider = sdkResp.Provider
+	return resp, nil
+}
+
+func (s *frameworkProviderServer) PrepareProviderConfig(context.Context, *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return &tfprotov5.PrepareProviderConfigResponse{}, nil
+}
+
+func (s *frameworkProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	emptyType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}
+	config, err := tfprotov5.NewDynamicValue(emptyType, tftypes.NewValue(emptyType, map[string]tftypes.Value{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ProviderServer.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: req.TerraformVersion,
+		Config:           &config,
+	})
+}
@@ -0,0 +1,39 @@
+package google
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := ProtoV5ProviderServerFactory(ctx, Provider())
+	if err != nil {
+		t.Fatalf("error creating the provider server: %s", err)
+	}
+
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("error getting the provider schema: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+	if resp.Provider == nil || len(resp.Provider.Block.Attributes) == 0 {
+		t.Errorf("expected the provider schema of the SDK provider, got %v", resp.Provider)
+	}
+
+	if _, ok := resp.ResourceSchemas["google_compute_instance"]; !ok {
+		t.Errorf("expected the SDK resource google_compute_instance to be served")
+	}
+	for _, newResource := range frameworkGeneratedResources() {
+		metadata := resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{}, &metadata)
+		if _, ok := resp.ResourceSchemas[metadata.TypeName]; !ok {
+			t.Errorf("expected the plugin-framework resource %s to be served", metadata.TypeName)
+		}
+	}
+}
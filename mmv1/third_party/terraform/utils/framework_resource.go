@@ -0,0 +1,801 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// frameworkField describes how an attribute of a generated plugin-framework
+// resource maps to a field of the API resource.
+type frameworkField struct {
+	// ApiName is the name of the field in the API resource. Attributes without
+	// an ApiName, like id and project, are neither sent nor read.
+	ApiName string
+	// Fields are the attributes of a nested object, keyed by attribute name.
+	Fields map[string]frameworkField
+	// Output fields are read from the API, but never sent.
+	Output bool
+	// IgnoreRead fields are sent to the API, but kept from the prior state on
+	// read because the API doesn't return them.
+	IgnoreRead bool
+}
+
+// frameworkResourceSpec holds everything a generated plugin-framework resource
+// needs to be managed by frameworkResource.
+type frameworkResourceSpec struct {
+	// TypeName is the Terraform type name of the resource, e.g.
+	// google_pubsub_topic.
+	TypeName string
+	// Name is the human readable name of the resource used in messages.
+	Name   string
+	Schema schema.Schema
+	Fields map[string]frameworkField
+
+	CreateUrl  string
+	CreateVerb string
+	ReadUrl    string
+	UpdateUrl  string
+	UpdateVerb string
+	// UpdateMask adds the changed fields as the updateMask query parameter of
+	// update requests.
+	UpdateMask bool
+	DeleteUrl  string
+	DeleteVerb string
+
+	IdFormat      string
+	ImportFormats []string
+	// HasProject resources read the project from the project attribute,
+	// falling back to the provider's project.
+	HasProject bool
+
+	Timeouts map[string]time.Duration
+	// WaitForOperation waits for a long-running operation returned by a
+	// create, update or delete request. It's nil if the API returns the
+	// resource directly.
+	WaitForOperation func(config *Config, op map[string]interface{}, project, activity, userAgent string, timeout time.Duration) error
+}
+
+// frameworkResourceBase reads the provider configuration of plugin-framework
+// resources. The provider server is expected to pass the same *Config the SDK
+// provider is configured with as provider data.
+type frameworkResourceBase struct {
+	config *Config
+}
+
+func (r *frameworkResourceBase) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Provider data is nil while the provider isn't configured yet.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *Config, got %T", req.ProviderData))
+		return
+	}
+	r.config = config
+}
+
+// frameworkResource implements a plugin-framework resource for a REST
+// resource generated from its api.yaml definition.
+type frameworkResource struct {
+	frameworkResourceBase
+	spec *frameworkResourceSpec
+}
+
+var (
+	_ resource.Resource                = &frameworkResource{}
+	_ resource.ResourceWithConfigure   = &frameworkResource{}
+	_ resource.ResourceWithImportState = &frameworkResource{}
+)
+
+func newFrameworkResource(spec *frameworkResourceSpec) resource.Resource {
+	return &frameworkResource{spec: spec}
+}
+
+func (r *frameworkResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.spec.TypeName
+}
+
+func (r *frameworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.spec.Schema
+}
+
+func (r *frameworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	d, err := newFrameworkResourceData(req.Plan.Raw, r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the plan of %s", r.spec.Name), err.Error())
+		return
+	}
+
+	if err := r.create(d); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating %s", r.spec.Name), err.Error())
+		return
+	}
+
+	state, err := r.readState(ctx, d)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s", r.spec.Name), err.Error())
+		return
+	}
+	resp.State.Raw = r.keepPlannedValues(state, req.Plan.Raw)
+}
+
+func (r *frameworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	d, err := newFrameworkResourceData(req.State.Raw, r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the state of %s", r.spec.Name), err.Error())
+		return
+	}
+
+	state, err := r.readState(ctx, d)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] Removing %s %q because it's gone", r.spec.Name, d.Id())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s", r.spec.Name), err.Error())
+		return
+	}
+	resp.State.Raw = state
+}
+
+func (r *frameworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	d, err := newFrameworkResourceData(req.Plan.Raw, r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the plan of %s", r.spec.Name), err.Error())
+		return
+	}
+	prior, err := newFrameworkResourceData(req.State.Raw, r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the state of %s", r.spec.Name), err.Error())
+		return
+	}
+	d.SetId(prior.Id())
+
+	if err := r.update(d, prior); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating %s", r.spec.Name), err.Error())
+		return
+	}
+
+	state, err := r.readState(ctx, d)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %s", r.spec.Name), err.Error())
+		return
+	}
+	resp.State.Raw = r.keepPlannedValues(state, req.Plan.Raw)
+}
+
+func (r *frameworkResource) Delete(_ context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	d, err := newFrameworkResourceData(req.State.Raw, r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading the state of %s", r.spec.Name), err.Error())
+		return
+	}
+
+	if err := r.delete(d); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting %s", r.spec.Name), err.Error())
+	}
+}
+
+func (r *frameworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	d, err := newFrameworkResourceData(tftypes.NewValue(r.spec.Schema.Type().TerraformType(ctx), nil), r.spec.Timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error importing %s", r.spec.Name), err.Error())
+		return
+	}
+	d.SetId(req.ID)
+
+	if err := parseImportId(r.spec.ImportFormats, d, r.config); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error importing %s", r.spec.Name), err.Error())
+		return
+	}
+
+	id, err := replaceVars(d, r.config, r.spec.IdFormat)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error importing %s", r.spec.Name), fmt.Sprintf("Error constructing id: %s", err))
+		return
+	}
+	d.SetId(id)
+
+	// The remaining attributes are set by the read following the import.
+	state, err := d.state()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error importing %s", r.spec.Name), err.Error())
+		return
+	}
+	resp.State.Raw = state
+}
+
+// readState reads the resource and returns it as a state value.
+func (r *frameworkResource) readState(ctx context.Context, d *frameworkResourceData) (tftypes.Value, error) {
+	res, err := r.read(d)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return d.stateFromResponse(r.spec.Schema.Type().TerraformType(ctx), res, r.spec.Fields)
+}
+
+// keepPlannedValues returns the state with the planned values of attributes
+// that aren't computed. Terraform requires them to match the plan after an
+// apply, even if the API returns them in another form.
+func (r *frameworkResource) keepPlannedValues(state, plan tftypes.Value) tftypes.Value {
+	var stateAttrs, planAttrs map[string]tftypes.Value
+	if err := state.As(&stateAttrs); err != nil {
+		return state
+	}
+	if err := plan.As(&planAttrs); err != nil {
+		return state
+	}
+
+	for k, attr := range r.spec.Schema.Attributes {
+		if v, ok := planAttrs[k]; ok && !attr.IsComputed() && v.IsFullyKnown() {
+			stateAttrs[k] = v
+		}
+	}
+	return tftypes.NewValue(state.Type(), stateAttrs)
+}
+
+func (r *frameworkResource) create(d *frameworkResourceData) error {
+	config := r.config
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := expandFrameworkFields(d.values, r.spec.Fields)
+
+	url, err := replaceVars(d, config, r.spec.CreateUrl)
+	if err != nil {
+		return err
+	}
+
+	project, billingProject, err := r.projects(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new %s: %#v", r.spec.Name, obj)
+	res, err := sendRequestWithTimeout(config, r.spec.CreateVerb, billingProject, url, userAgent, obj, d.Timeout(frameworkTimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, r.spec.IdFormat)
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if r.spec.WaitForOperation != nil {
+		if err := r.spec.WaitForOperation(config, res, project, fmt.Sprintf("Creating %s", r.spec.Name), userAgent, d.Timeout(frameworkTimeoutCreate)); err != nil {
+			return fmt.Errorf("Error waiting to create %s: %s", r.spec.Name, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating %s %q: %#v", r.spec.Name, d.Id(), res)
+	return nil
+}
+
+func (r *frameworkResource) read(d *frameworkResourceData) (map[string]interface{}, error) {
+	config := r.config
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := replaceVars(d, config, r.spec.ReadUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	_, billingProject, err := r.projects(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendRequest(config, "GET", billingProject, url, userAgent, nil)
+}
+
+func (r *frameworkResource) update(d, prior *frameworkResourceData) error {
+	config := r.config
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := expandFrameworkFields(d.values, r.spec.Fields)
+	changed := frameworkChangedFields(obj, expandFrameworkFields(prior.values, r.spec.Fields))
+	if len(changed) == 0 || r.spec.UpdateUrl == "" {
+		return nil
+	}
+
+	url, err := replaceVars(d, config, r.spec.UpdateUrl)
+	if err != nil {
+		return err
+	}
+	if r.spec.UpdateMask {
+		url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(changed, ",")})
+		if err != nil {
+			return err
+		}
+	}
+
+	project, billingProject, err := r.projects(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating %s %q: %#v", r.spec.Name, d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, r.spec.UpdateVerb, billingProject, url, userAgent, obj, d.Timeout(frameworkTimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	if r.spec.WaitForOperation != nil {
+		if err := r.spec.WaitForOperation(config, res, project, fmt.Sprintf("Updating %s", r.spec.Name), userAgent, d.Timeout(frameworkTimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting to update %s: %s", r.spec.Name, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished updating %s %q: %#v", r.spec.Name, d.Id(), res)
+	return nil
+}
+
+func (r *frameworkResource) delete(d *frameworkResourceData) error {
+	config := r.config
+	userAgent, err := generateUserAgentString(d, config.userAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, r.spec.DeleteUrl)
+	if err != nil {
+		return err
+	}
+
+	project, billingProject, err := r.projects(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s %q", r.spec.Name, d.Id())
+	res, err := sendRequestWithTimeout(config, r.spec.DeleteVerb, billingProject, url, userAgent, nil, d.Timeout(frameworkTimeoutDelete))
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] %s %q is already gone", r.spec.Name, d.Id())
+			return nil
+		}
+		return err
+	}
+
+	if r.spec.WaitForOperation != nil {
+		if err := r.spec.WaitForOperation(config, res, project, fmt.Sprintf("Deleting %s", r.spec.Name), userAgent, d.Timeout(frameworkTimeoutDelete)); err != nil {
+			return fmt.Errorf("Error waiting to delete %s: %s", r.spec.Name, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finished deleting %s %q: %#v", r.spec.Name, d.Id(), res)
+	return nil
+}
+
+// projects returns the project of the resource, which is also recorded in
+// its state, and the project to bill requests to.
+func (r *frameworkResource) projects(d *frameworkResourceData) (string, string, error) {
+	project := ""
+	billingProject := ""
+	if r.spec.HasProject {
+		var err error
+		project, err = getProject(d, r.config)
+		if err != nil {
+			return "", "", fmt.Errorf("Error fetching project for %s: %s", r.spec.Name, err)
+		}
+		if err := d.Set("project", project); err != nil {
+			return "", "", err
+		}
+		billingProject = project
+	}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := getBillingProject(d, r.config); err == nil {
+		billingProject = bp
+	}
+
+	return project, billingProject, nil
+}
+
+// The timeout keys match the ones of SDK resources.
+const (
+	frameworkTimeoutCreate = "create"
+	frameworkTimeoutUpdate = "update"
+	frameworkTimeoutDelete = "delete"
+)
+
+// frameworkResourceData adapts the values of a plugin-framework plan or state
+// to TerraformResourceData, so that generated plugin-framework resources can
+// use the same helpers as SDK resources, like replaceVars and parseImportId.
+// Values are converted to the types the SDK uses: strings, ints or float64s,
+// bools, []interface{} for lists and sets, and map[string]interface{} for maps
+// and nested objects.
+type frameworkResourceData struct {
+	typ      tftypes.Object
+	values   map[string]interface{}
+	timeouts map[string]time.Duration
+}
+
+var _ TerraformResourceData = &frameworkResourceData{}
+
+func newFrameworkResourceData(raw tftypes.Value, timeouts map[string]time.Duration) (*frameworkResourceData, error) {
+	typ, ok := raw.Type().(tftypes.Object)
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %s", raw.Type())
+	}
+
+	v, err := frameworkValueToGo(raw)
+	if err != nil {
+		return nil, err
+	}
+	values, _ := v.(map[string]interface{})
+	if values == nil {
+		values = make(map[string]interface{})
+	}
+
+	return &frameworkResourceData{
+		typ:      typ,
+		values:   values,
+		timeouts: timeouts,
+	}, nil
+}
+
+func (d *frameworkResourceData) HasChange(string) bool {
+	return false
+}
+
+func (d *frameworkResourceData) GetOkExists(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	if !ok || v == nil {
+		return d.zeroValue(key), false
+	}
+	return v, true
+}
+
+func (d *frameworkResourceData) GetOk(key string) (interface{}, bool) {
+	v, ok := d.GetOkExists(key)
+	if !ok {
+		return v, false
+	}
+	return v, !isEmptyValue(reflect.ValueOf(v))
+}
+
+func (d *frameworkResourceData) Get(key string) interface{} {
+	v, _ := d.GetOkExists(key)
+	return v
+}
+
+func (d *frameworkResourceData) Set(key string, value interface{}) error {
+	if _, ok := d.typ.AttributeTypes[key]; !ok {
+		return fmt.Errorf("Invalid address to set: %q", key)
+	}
+	d.values[key] = value
+	return nil
+}
+
+func (d *frameworkResourceData) SetId(id string) {
+	d.values["id"] = id
+}
+
+func (d *frameworkResourceData) Id() string {
+	id, _ := d.values["id"].(string)
+	return id
+}
+
+// GetProviderMeta is a no-op, as provider_meta isn't passed to generated
+// plugin-framework resources.
+func (d *frameworkResourceData) GetProviderMeta(interface{}) error {
+	return nil
+}
+
+func (d *frameworkResourceData) Timeout(key string) time.Duration {
+	if t, ok := d.timeouts[key]; ok {
+		return t
+	}
+	return 20 * time.Minute
+}
+
+// zeroValue returns the value the SDK returns for an unset attribute.
+func (d *frameworkResourceData) zeroValue(key string) interface{} {
+	switch typ := d.typ.AttributeTypes[key]; {
+	case typ == nil:
+		return nil
+	case typ.Is(tftypes.String):
+		return ""
+	case typ.Is(tftypes.Number):
+		return 0
+	case typ.Is(tftypes.Bool):
+		return false
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		return []interface{}{}
+	case typ.Is(tftypes.Map{}):
+		return map[string]interface{}{}
+	}
+	return nil
+}
+
+// state returns the values as a state value.
+func (d *frameworkResourceData) state() (tftypes.Value, error) {
+	return frameworkValueFromGo(d.typ, d.values)
+}
+
+// stateFromResponse returns the state of the resource read from the API. The
+// attributes that aren't read from the API are kept as they are.
+func (d *frameworkResourceData) stateFromResponse(typ tftypes.Type, res map[string]interface{}, fields map[string]frameworkField) (tftypes.Value, error) {
+	values := flattenFrameworkFields(res, fields)
+	for key, field := range fields {
+		if field.ApiName == "" || field.IgnoreRead {
+			values[key] = d.values[key]
+		}
+	}
+	values["id"] = d.Id()
+
+	return frameworkValueFromGo(typ, values)
+}
+
+// expandFrameworkFields returns the API representation of the attribute
+// values. Null and unknown values, and output fields, aren't sent.
+func expandFrameworkFields(values map[string]interface{}, fields map[string]frameworkField) map[string]interface{} {
+	obj := make(map[string]interface{})
+	for key, field := range fields {
+		v := values[key]
+		if field.ApiName == "" || field.Output || v == nil {
+			continue
+		}
+		obj[field.ApiName] = expandFrameworkField(v, field)
+	}
+	return obj
+}
+
+func expandFrameworkField(v interface{}, field frameworkField) interface{} {
+	if field.Fields == nil {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return expandFrameworkFields(v, field.Fields)
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, expandFrameworkField(item, field))
+		}
+		return items
+	}
+	return v
+}
+
+// flattenFrameworkFields returns the attribute values of an API resource.
+func flattenFrameworkFields(res map[string]interface{}, fields map[string]frameworkField) map[string]interface{} {
+	values := make(map[string]interface{})
+	for key, field := range fields {
+		if field.ApiName == "" {
+			continue
+		}
+		if v, ok := res[field.ApiName]; ok {
+			values[key] = flattenFrameworkField(v, field)
+		}
+	}
+	return values
+}
+
+func flattenFrameworkField(v interface{}, field frameworkField) interface{} {
+	if field.Fields == nil {
+		return v
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return flattenFrameworkFields(v, field.Fields)
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, flattenFrameworkField(item, field))
+		}
+		return items
+	}
+	return v
+}
+
+// frameworkChangedFields returns the sorted names of the top-level fields
+// that differ between two API representations.
+func frameworkChangedFields(obj, prior map[string]interface{}) []string {
+	changed := make([]string, 0)
+	for k, v := range obj {
+		if !reflect.DeepEqual(v, prior[k]) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range prior {
+		if _, ok := obj[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// frameworkValueToGo converts a plugin-framework value to the types the SDK
+// uses. Null and unknown values are converted to nil.
+func frameworkValueToGo(v tftypes.Value) (interface{}, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+		if f.IsInt() {
+			i, _ := f.Int64()
+			return int(i), nil
+		}
+		f64, _ := f.Float64()
+		return f64, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		items := make([]interface{}, 0, len(elems))
+		for _, elem := range elems {
+			item, err := frameworkValueToGo(elem)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(attrs))
+		for k, attr := range attrs {
+			item, err := frameworkValueToGo(attr)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = item
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// frameworkValueFromGo converts a value of the types the SDK uses, or decoded
+// from an API response, to a plugin-framework value of the given type.
+// Attributes missing from objects are set to null.
+func frameworkValueFromGo(typ tftypes.Type, v interface{}) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected an object, got %T", v)
+		}
+		attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for k, attrType := range typ.AttributeTypes {
+			attr, err := frameworkValueFromGo(attrType, m[k])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %s", k, err)
+			}
+			attrs[k] = attr
+		}
+		return tftypes.NewValue(typ, attrs), nil
+	case tftypes.Map:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a map, got %T", v)
+		}
+		elems := make(map[string]tftypes.Value, len(m))
+		for k, item := range m {
+			elem, err := frameworkValueFromGo(typ.ElementType, item)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %s", k, err)
+			}
+			elems[k] = elem
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.List, tftypes.Set:
+		items, ok := v.([]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a list, got %T", v)
+		}
+		var elemType tftypes.Type
+		if l, ok := typ.(tftypes.List); ok {
+			elemType = l.ElementType
+		} else {
+			elemType = typ.(tftypes.Set).ElementType
+		}
+		elems := make([]tftypes.Value, 0, len(items))
+		for i, item := range items {
+			elem, err := frameworkValueFromGo(elemType, item)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%d: %s", i, err)
+			}
+			elems = append(elems, elem)
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		if s, ok := v.(string); ok {
+			return tftypes.NewValue(typ, s), nil
+		}
+		return tftypes.NewValue(typ, fmt.Sprintf("%v", v)), nil
+	case typ.Is(tftypes.Bool):
+		b, ok := v.(bool)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a bool, got %T", v)
+		}
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		f, err := frameworkNumber(v)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(typ, f), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+}
+
+// frameworkNumber converts a number to a *big.Float. int64 fields are encoded
+// as strings in API responses.
+func frameworkNumber(v interface{}) (*big.Float, error) {
+	switch v := v.(type) {
+	case int:
+		return new(big.Float).SetInt64(int64(v)), nil
+	case int64:
+		return new(big.Float).SetInt64(v), nil
+	case float64:
+		return big.NewFloat(v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		return f, err
+	case string:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("expected a number, got %q", v)
+		}
+		f, _, err := big.ParseFloat(v, 10, 512, big.ToNearestEven)
+		return f, err
+	}
+	return nil, fmt.Errorf("expected a number, got %T", v)
+}
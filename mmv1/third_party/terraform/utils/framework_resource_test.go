@@ -0,0 +1,255 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testFrameworkType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id":           tftypes.String,
+		"name":         tftypes.String,
+		"size_gb":      tftypes.Number,
+		"enabled":      tftypes.Bool,
+		"labels":       tftypes.Map{ElementType: tftypes.String},
+		"create_time":  tftypes.String,
+		"source_image": tftypes.String,
+		"config": tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"zones": tftypes.List{ElementType: tftypes.String},
+			},
+		},
+	},
+}
+
+var testFrameworkFields = map[string]frameworkField{
+	"id":           {},
+	"name":         {ApiName: "name"},
+	"size_gb":      {ApiName: "sizeGb"},
+	"enabled":      {ApiName: "enabled"},
+	"labels":       {ApiName: "labels"},
+	"create_time":  {ApiName: "createTime", Output: true},
+	"source_image": {ApiName: "sourceImage", IgnoreRead: true},
+	"config": {
+		ApiName: "config",
+		Fields: map[string]frameworkField{
+			"zones": {ApiName: "zones"},
+		},
+	},
+}
+
+func TestFrameworkValue_roundTrip(t *testing.T) {
+	values := map[string]interface{}{
+		"id":      "projects/p/disks/d",
+		"name":    "d",
+		"size_gb": 10,
+		"enabled": true,
+		"labels": map[string]interface{}{
+			"env": "test",
+		},
+		"config": map[string]interface{}{
+			"zones": []interface{}{"us-central1-a", "us-central1-b"},
+		},
+	}
+
+	v, err := frameworkValueFromGo(testFrameworkType, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := frameworkValueToGo(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Attributes missing from the values are null.
+	values["create_time"] = nil
+	values["source_image"] = nil
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("expected %#v, got %#v", values, got)
+	}
+}
+
+func TestFrameworkValueFromGo_int64String(t *testing.T) {
+	v, err := frameworkValueFromGo(tftypes.Number, "1099511627776")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := frameworkValueToGo(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != 1099511627776 {
+		t.Errorf("expected 1099511627776, got %#v", got)
+	}
+
+	if _, err := frameworkValueFromGo(tftypes.Number, "ten"); err == nil {
+		t.Errorf("expected an error for a string that isn't a number")
+	}
+}
+
+func TestExpandFrameworkFields(t *testing.T) {
+	values := map[string]interface{}{
+		"id":           "projects/p/disks/d",
+		"name":         "d",
+		"size_gb":      nil,
+		"create_time":  "2022-01-01T00:00:00Z",
+		"source_image": "debian-11",
+		"config": map[string]interface{}{
+			"zones": []interface{}{"us-central1-a"},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"name":        "d",
+		"sourceImage": "debian-11",
+		"config": map[string]interface{}{
+			"zones": []interface{}{"us-central1-a"},
+		},
+	}
+	if got := expandFrameworkFields(values, testFrameworkFields); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFrameworkResourceData_stateFromResponse(t *testing.T) {
+	prior, err := frameworkValueFromGo(testFrameworkType, map[string]interface{}{
+		"id":           "projects/p/disks/d",
+		"name":         "d",
+		"source_image": "debian-11",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	d, err := newFrameworkResourceData(prior, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	res := map[string]interface{}{
+		"name":       "d",
+		"sizeGb":     "10",
+		"createTime": "2022-01-01T00:00:00Z",
+		"config": map[string]interface{}{
+			"zones": []interface{}{"us-central1-a"},
+		},
+	}
+	state, err := d.stateFromResponse(testFrameworkType, res, testFrameworkFields)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := frameworkValueToGo(state)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"id":           "projects/p/disks/d",
+		"name":         "d",
+		"size_gb":      10,
+		"enabled":      nil,
+		"labels":       nil,
+		"create_time":  "2022-01-01T00:00:00Z",
+		"source_image": "debian-11",
+		"config": map[string]interface{}{
+			"zones": []interface{}{"us-central1-a"},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
+
+func TestFrameworkResourceData_getOk(t *testing.T) {
+	v, err := frameworkValueFromGo(testFrameworkType, map[string]interface{}{
+		"name":    "",
+		"enabled": false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	d, err := newFrameworkResourceData(v, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, ok := d.GetOk("name"); ok || got != "" {
+		t.Errorf("expected an empty name to not be set, got %#v", got)
+	}
+	if got, ok := d.GetOkExists("enabled"); !ok || got != false {
+		t.Errorf("expected enabled to exist, got %#v", got)
+	}
+	if got, ok := d.GetOk("size_gb"); ok || got != 0 {
+		t.Errorf("expected a null size_gb to read as 0, got %#v", got)
+	}
+	if err := d.Set("unknown", "value"); err == nil {
+		t.Errorf("expected an error setting an unknown attribute")
+	}
+}
+
+func TestFrameworkChangedFields(t *testing.T) {
+	obj := map[string]interface{}{
+		"name":   "d",
+		"sizeGb": 20,
+		"labels": map[string]interface{}{"env": "prod"},
+	}
+	prior := map[string]interface{}{
+		"name":        "d",
+		"sizeGb":      10,
+		"description": "removed",
+	}
+
+	expected := []string{"description", "labels", "sizeGb"}
+	if got := frameworkChangedFields(obj, prior); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestFrameworkResource_keepPlannedValues(t *testing.T) {
+	r := &frameworkResource{
+		spec: &frameworkResourceSpec{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+					"zone": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+			"zone": tftypes.String,
+		},
+	}
+
+	plan := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name": tftypes.NewValue(tftypes.String, "d"),
+		"zone": tftypes.NewValue(tftypes.String, "us-central1-a"),
+	})
+	state := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "projects/p/zones/us-central1-a/disks/d"),
+		"name": tftypes.NewValue(tftypes.String, "d"),
+		"zone": tftypes.NewValue(tftypes.String, "projects/p/zones/us-central1-a"),
+	})
+
+	got, err := frameworkValueToGo(r.keepPlannedValues(state, plan))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"id":   "projects/p/zones/us-central1-a/disks/d",
+		"name": "d",
+		"zone": "us-central1-a",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+}
@@ -55,3 +55,168 @@ func isSweepableTestResource(resourceName string) bool {
 	}
 	return false
 }
+
+// sweeperFieldSets returns one set of URL variables per substitution, each
+// layered over the shared base values. With no substitutions, the base values
+// are swept once.
+func sweeperFieldSets(base map[string]interface{}, substitutions []map[string]interface{}) []map[string]interface{} {
+	if len(substitutions) == 0 {
+		return []map[string]interface{}{base}
+	}
+
+	fieldSets := make([]map[string]interface{}, 0, len(substitutions))
+	for _, substitution := range substitutions {
+		fields := make(map[string]interface{}, len(base)+len(substitution))
+		for k, v := range base {
+			fields[k] = v
+		}
+		for k, v := range substitution {
+			fields[k] = v
+		}
+		fieldSets = append(fieldSets, fields)
+	}
+	return fieldSets
+}
+
+// sweeperParentFieldSets lists the parent collection for each field set and
+// returns a copy of it per parent, with param set to the parent's short name.
+func sweeperParentFieldSets(config *Config, fieldSets []map[string]interface{}, listTemplate, collection, param string) ([]map[string]interface{}, error) {
+	var parentFieldSets []map[string]interface{}
+	for _, fields := range fieldSets {
+		listUrl, err := replaceVars(&ResourceDataMock{FieldsInSchema: fields}, config, listTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("error preparing parent list url: %s", err)
+		}
+
+		err = listPaginatedItems(config, config.Project, listUrl, config.userAgent, nil, func(res map[string]interface{}) error {
+			parents, _ := res[collection].([]interface{})
+			for _, p := range parents {
+				name, _ := p.(map[string]interface{})["name"].(string)
+				if name == "" {
+					continue
+				}
+
+				parentFields := sweeperFieldSets(fields, []map[string]interface{}{{param: GetResourceNameFromSelfLink(name)}})
+				parentFieldSets = append(parentFieldSets, parentFields...)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing parents at %s: %s", listUrl, err)
+		}
+	}
+	return parentFieldSets, nil
+}
+
+// sweeperLabelsMatch reports whether any of the given labels is set on the
+// listed item.
+func sweeperLabelsMatch(obj map[string]interface{}, labels map[string]string) bool {
+	objLabels, ok := obj["labels"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for k, v := range labels {
+		if objLabels[k] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// sweeperShouldSkip reports whether a top-level field of the listed item has
+// one of the values that exclude it from sweeping.
+func sweeperShouldSkip(obj map[string]interface{}, skipIf map[string]string) bool {
+	for k, v := range skipIf {
+		if obj[k] != nil && fmt.Sprintf("%v", obj[k]) == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSweeperFieldSets(t *testing.T) {
+	base := map[string]interface{}{"project": "my-project", "location": "us-central1"}
+
+	fieldSets := sweeperFieldSets(base, nil)
+	if len(fieldSets) != 1 || fieldSets[0]["location"] != "us-central1" {
+		t.Fatalf("expected the base fields to be swept once, got %v", fieldSets)
+	}
+
+	fieldSets = sweeperFieldSets(base, []map[string]interface{}{
+		{"location": "global"},
+		{"location": "europe-west1", "api": "tf-test-api"},
+	})
+	if len(fieldSets) != 2 {
+		t.Fatalf("expected one field set per substitution, got %v", fieldSets)
+	}
+	if fieldSets[0]["location"] != "global" || fieldSets[0]["project"] != "my-project" {
+		t.Errorf("unexpected first field set: %v", fieldSets[0])
+	}
+	if fieldSets[1]["location"] != "europe-west1" || fieldSets[1]["api"] != "tf-test-api" {
+		t.Errorf("unexpected second field set: %v", fieldSets[1])
+	}
+	if base["location"] != "us-central1" {
+		t.Errorf("base fields were modified: %v", base)
+	}
+}
+
+func TestSweeperLabelsMatch(t *testing.T) {
+	labels := map[string]string{"goog-terraform-test": "true"}
+
+	cases := map[string]struct {
+		Obj      map[string]interface{}
+		Expected bool
+	}{
+		"no labels": {
+			Obj:      map[string]interface{}{"name": "foo"},
+			Expected: false,
+		},
+		"matching label": {
+			Obj:      map[string]interface{}{"labels": map[string]interface{}{"goog-terraform-test": "true", "env": "dev"}},
+			Expected: true,
+		},
+		"different value": {
+			Obj:      map[string]interface{}{"labels": map[string]interface{}{"goog-terraform-test": "false"}},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := sweeperLabelsMatch(tc.Obj, labels); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestSweeperShouldSkip(t *testing.T) {
+	skipIf := map[string]string{"state": "DELETING", "default": "true"}
+
+	cases := map[string]struct {
+		Obj      map[string]interface{}
+		Expected bool
+	}{
+		"no matching field": {
+			Obj:      map[string]interface{}{"name": "foo"},
+			Expected: false,
+		},
+		"matching string": {
+			Obj:      map[string]interface{}{"state": "DELETING"},
+			Expected: true,
+		},
+		"matching bool": {
+			Obj:      map[string]interface{}{"default": true},
+			Expected: true,
+		},
+		"other value": {
+			Obj:      map[string]interface{}{"state": "ACTIVE"},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := sweeperShouldSkip(tc.Obj, skipIf); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
@@ -1,6 +1,5 @@
-<% autogen_exception -%>
 package google
-<% if version == 'private' -%>
+
 import (
 	"encoding/json"
 	"fmt"
@@ -9,6 +8,9 @@ import (
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 )
 
+// gkeonpremOpError is the error of a failed operation. The API reports why a
+// cluster failed its preflight checks in the validationCheck of the error
+// details, which is included in the error message.
 type gkeonpremOpError struct {
 	*cloudresourcemanager.Status
 }
@@ -94,7 +96,7 @@ func (w *gkeonpremOperationWaiter) QueryOp() (interface{}, error) {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	// Returns the proper get.
-	url := fmt.Sprintf("%s%s", w.Config.gkeonpremBasePath, w.Op.Name)
+	url := fmt.Sprintf("%s%s", w.Config.GkeonpremBasePath, w.Op.Name)
 
 	return sendRequest(w.Config, "GET", w.Project, url, w.UserAgent, nil)
 }
@@ -135,4 +137,3 @@ func gkeonpremOperationWaitTime(config *Config, op map[string]interface{}, proje
 	}
 	return OperationWait(w, activity, timeout, config.PollInterval)
 }
-<% end -%>
\ No newline at end of file
@@ -0,0 +1,47 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gkeonpremVersionUpgradeCustomizeDiff returns a CustomizeDiff validating
+// changes of the version of an on-prem cluster, held in field, at plan time.
+func gkeonpremVersionUpgradeCustomizeDiff(field string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		return gkeonpremValidateVersionUpgrade(diff, field)
+	}
+}
+
+// gkeonpremValidateVersionUpgrade rejects version changes the API would fail
+// on after the apply started: on-prem clusters can't be downgraded and are
+// upgraded one minor version at a time. Versions it can't parse, such as the
+// ones of a cluster being created, are left to the API.
+func gkeonpremValidateVersionUpgrade(diff TerraformResourceDiff, field string) error {
+	if !diff.HasChange(field) {
+		return nil
+	}
+
+	o, n := diff.GetChange(field)
+	oldVersion, err := version.NewVersion(o.(string))
+	if err != nil {
+		return nil
+	}
+	newVersion, err := version.NewVersion(n.(string))
+	if err != nil {
+		return nil
+	}
+
+	if newVersion.LessThan(oldVersion) {
+		return fmt.Errorf("%s can't be downgraded from %s to %s", field, oldVersion.Original(), newVersion.Original())
+	}
+
+	oldSegments, newSegments := oldVersion.Segments(), newVersion.Segments()
+	if newSegments[0] != oldSegments[0] || newSegments[1] > oldSegments[1]+1 {
+		return fmt.Errorf("%s can only be upgraded one minor version at a time, from %s to %d.%d.x, got %s", field, oldVersion.Original(), oldSegments[0], oldSegments[1]+1, newVersion.Original())
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package google
+
+import "testing"
+
+func TestGkeonpremValidateVersionUpgrade(t *testing.T) {
+	cases := map[string]struct {
+		Before, After string
+		ExpectError   bool
+	}{
+		"unchanged": {
+			Before: "1.28.100-gke.131",
+			After:  "1.28.100-gke.131",
+		},
+		"create": {
+			Before: "",
+			After:  "1.28.100-gke.131",
+		},
+		"patch upgrade": {
+			Before: "1.28.100-gke.131",
+			After:  "1.28.200-gke.111",
+		},
+		"minor upgrade": {
+			Before: "1.28.100-gke.131",
+			After:  "1.29.0-gke.1456",
+		},
+		"gke build upgrade": {
+			Before: "1.16.0-gke.26",
+			After:  "1.16.0-gke.31",
+		},
+		"minor version skipped": {
+			Before:      "1.28.100-gke.131",
+			After:       "1.30.0-gke.1930",
+			ExpectError: true,
+		},
+		"major upgrade": {
+			Before:      "1.31.0-gke.889",
+			After:       "2.0.0-gke.1",
+			ExpectError: true,
+		},
+		"downgrade": {
+			Before:      "1.29.0-gke.1456",
+			After:       "1.28.100-gke.131",
+			ExpectError: true,
+		},
+		"gke build downgrade": {
+			Before:      "1.16.0-gke.31",
+			After:       "1.16.0-gke.26",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: map[string]interface{}{"on_prem_version": tc.Before},
+			After:  map[string]interface{}{"on_prem_version": tc.After},
+		}
+		err := gkeonpremValidateVersionUpgrade(d, "on_prem_version")
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: expected no error, got %s", tn, err)
+		}
+	}
+}
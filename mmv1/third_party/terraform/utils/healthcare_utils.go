@@ -233,3 +233,21 @@ func parseHealthcareDicomStoreId(id string, config *Config) (*healthcareDicomSto
 	}
 	return nil, fmt.Errorf("Invalid DicomStore id format, expecting `{projectId}/{locationId}/{datasetName}/{dicomStoreName}` or `{locationId}/{datasetName}/{dicomStoreName}.`")
 }
+
+// listHealthcareResources pages through a Healthcare API list call and returns
+// the raw objects stored under key across all pages.
+func listHealthcareResources(config *Config, billingProject, url, userAgent, key string) ([]interface{}, error) {
+	items := make([]interface{}, 0)
+
+	err := listPaginatedItems(config, billingProject, url, userAgent, nil, func(res map[string]interface{}) error {
+		if v, ok := res[key].([]interface{}); ok {
+			items = append(items, v...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
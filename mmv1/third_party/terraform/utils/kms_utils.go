@@ -266,3 +266,20 @@ func disableCryptoKeyRotation(cryptoKeyId *kmsCryptoKeyId, userAgent string, con
 
 	return err
 }
+
+// PollCheckKmsCryptoKeyVersionImported waits for an imported CryptoKeyVersion to
+// leave the PENDING_IMPORT state, and returns the import failure reason if the
+// key material could not be imported.
+func PollCheckKmsCryptoKeyVersionImported(resp map[string]interface{}, respErr error) PollResult {
+	if respErr != nil {
+		return ErrorPollResult(respErr)
+	}
+
+	switch state, _ := resp["state"].(string); state {
+	case "PENDING_IMPORT":
+		return PendingStatusPollResult(state)
+	case "IMPORT_FAILED":
+		return ErrorPollResult(fmt.Errorf("Error importing CryptoKeyVersion: %v", resp["importFailureReason"]))
+	}
+	return SuccessPollResult()
+}
@@ -0,0 +1,169 @@
+package google
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources following the shared labels model expose three fields:
+//   - the labels field (usually `labels`), which is non-authoritative and only
+//     holds the labels present in the configuration.
+//   - `terraform_labels`, the labels in the configuration merged with the
+//     provider's `default_labels`.
+//   - `effective_labels`, all the labels present on the resource, including the
+//     ones added by other clients and services. This is what is sent to the API.
+//
+// Resources with annotations expose `annotations` and `effective_annotations`
+// the same way. There are no default annotations, so there's no
+// `terraform_annotations`.
+
+func terraformLabelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `The combination of labels configured directly on the resource and default labels configured on the provider.`,
+	}
+}
+
+func effectiveLabelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+	}
+}
+
+func effectiveAnnotationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+	}
+}
+
+// SetLabelsDiff is a CustomizeDiffFunc computing `terraform_labels` and
+// `effective_labels` from the `labels` field.
+func SetLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsDiffForField("labels")(ctx, d, meta)
+}
+
+// setLabelsDiffForField returns a CustomizeDiffFunc computing `terraform_labels`
+// and `effective_labels` from the given labels field.
+func setLabelsDiffForField(field string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		// The merged labels can't be known before the configured labels are.
+		if !d.NewValueKnown(field) {
+			if err := d.SetNewComputed("terraform_labels"); err != nil {
+				return fmt.Errorf("error setting terraform_labels to computed: %w", err)
+			}
+			if err := d.SetNewComputed("effective_labels"); err != nil {
+				return fmt.Errorf("error setting effective_labels to computed: %w", err)
+			}
+			return nil
+		}
+
+		terraformLabels := make(map[string]interface{})
+		if config, ok := meta.(*Config); ok {
+			for k, v := range config.DefaultLabels {
+				terraformLabels[k] = v
+			}
+		}
+		for k, v := range d.Get(field).(map[string]interface{}) {
+			terraformLabels[k] = v
+		}
+		if err := d.SetNew("terraform_labels", terraformLabels); err != nil {
+			return fmt.Errorf("error setting new terraform_labels diff: %w", err)
+		}
+
+		o, _ := d.GetChange("terraform_labels")
+		effectiveLabels := mergeEffectiveLabels(d.Get("effective_labels").(map[string]interface{}), o.(map[string]interface{}), terraformLabels)
+		if err := d.SetNew("effective_labels", effectiveLabels); err != nil {
+			return fmt.Errorf("error setting new effective_labels diff: %w", err)
+		}
+		return nil
+	}
+}
+
+// SetAnnotationsDiff is a CustomizeDiffFunc computing `effective_annotations`
+// from the `annotations` field.
+func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("annotations") {
+		if err := d.SetNewComputed("effective_annotations"); err != nil {
+			return fmt.Errorf("error setting effective_annotations to computed: %w", err)
+		}
+		return nil
+	}
+
+	o, n := d.GetChange("annotations")
+	effectiveAnnotations := mergeEffectiveLabels(d.Get("effective_annotations").(map[string]interface{}), o.(map[string]interface{}), n.(map[string]interface{}))
+	if err := d.SetNew("effective_annotations", effectiveAnnotations); err != nil {
+		return fmt.Errorf("error setting new effective_annotations diff: %w", err)
+	}
+	return nil
+}
+
+// mergeEffectiveLabels returns the labels or annotations to send to the API,
+// given the ones present on the resource and the ones managed by Terraform
+// before and after the change. Values that were managed by Terraform and are
+// no longer configured are removed; values added outside of Terraform are kept.
+func mergeEffectiveLabels(effective, oldManaged, newManaged map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for k, v := range effective {
+		merged[k] = v
+	}
+	for k := range oldManaged {
+		if _, ok := newManaged[k]; !ok {
+			delete(merged, k)
+		}
+	}
+	for k, v := range newManaged {
+		merged[k] = v
+	}
+	return merged
+}
+
+// flattenLabelsForField returns the labels of the resource that are present in
+// the given labels field, so that labels set outside of Terraform don't cause
+// a diff.
+func flattenLabelsForField(labels map[string]string, d TerraformResourceData, field string) map[string]interface{} {
+	transformed := make(map[string]interface{})
+	if v, ok := d.GetOk(field); ok && labels != nil {
+		for k := range v.(map[string]interface{}) {
+			if val, ok := labels[k]; ok {
+				transformed[k] = val
+			}
+		}
+	}
+	return transformed
+}
+
+// flattenLabels is flattenLabelsForField for the `labels` field.
+func flattenLabels(labels map[string]string, d TerraformResourceData) map[string]interface{} {
+	return flattenLabelsForField(labels, d, "labels")
+}
+
+// flattenTerraformLabels returns the labels of the resource that are managed by
+// Terraform.
+func flattenTerraformLabels(labels map[string]string, d TerraformResourceData) map[string]interface{} {
+	return flattenLabelsForField(labels, d, "terraform_labels")
+}
+
+// flattenAnnotations is flattenLabelsForField for the `annotations` field.
+func flattenAnnotations(annotations map[string]string, d TerraformResourceData) map[string]interface{} {
+	return flattenLabelsForField(annotations, d, "annotations")
+}
+
+// expandEffectiveLabels returns the labels to send to the API.
+func expandEffectiveLabels(d TerraformResourceData) map[string]string {
+	return expandStringMap(d, "effective_labels")
+}
+
+// expandEffectiveAnnotations returns the annotations to send to the API.
+func expandEffectiveAnnotations(d TerraformResourceData) map[string]string {
+	return expandStringMap(d, "effective_annotations")
+}
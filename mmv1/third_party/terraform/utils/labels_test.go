@@ -0,0 +1,117 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenLabelsForField(t *testing.T) {
+	apiLabels := map[string]string{
+		"env":                       "prod",
+		"team":                      "platform",
+		"goog-dataflow-provided-id": "word_count",
+	}
+
+	cases := map[string]struct {
+		FieldsInSchema map[string]interface{}
+		Field          string
+		Expected       map[string]interface{}
+	}{
+		"only configured labels": {
+			FieldsInSchema: map[string]interface{}{
+				"labels": map[string]interface{}{"env": "prod"},
+			},
+			Field:    "labels",
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		"configured label missing from the resource": {
+			FieldsInSchema: map[string]interface{}{
+				"labels": map[string]interface{}{"env": "prod", "owner": "me"},
+			},
+			Field:    "labels",
+			Expected: map[string]interface{}{"env": "prod"},
+		},
+		"no configured labels": {
+			FieldsInSchema: map[string]interface{}{},
+			Field:          "labels",
+			Expected:       map[string]interface{}{},
+		},
+		"terraform labels": {
+			FieldsInSchema: map[string]interface{}{
+				"labels":           map[string]interface{}{"env": "prod"},
+				"terraform_labels": map[string]interface{}{"env": "prod", "team": "platform"},
+			},
+			Field:    "terraform_labels",
+			Expected: map[string]interface{}{"env": "prod", "team": "platform"},
+		},
+		"other labels field": {
+			FieldsInSchema: map[string]interface{}{
+				"resource_labels": map[string]interface{}{"team": "platform"},
+			},
+			Field:    "resource_labels",
+			Expected: map[string]interface{}{"team": "platform"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDataMock{FieldsInSchema: tc.FieldsInSchema}
+		got := flattenLabelsForField(apiLabels, d, tc.Field)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestMergeEffectiveLabels(t *testing.T) {
+	cases := map[string]struct {
+		Effective  map[string]interface{}
+		OldManaged map[string]interface{}
+		NewManaged map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		"labels added outside of Terraform are kept": {
+			Effective:  map[string]interface{}{"env": "prod", "goog-managed": "true"},
+			OldManaged: map[string]interface{}{"env": "prod"},
+			NewManaged: map[string]interface{}{"env": "prod"},
+			Expected:   map[string]interface{}{"env": "prod", "goog-managed": "true"},
+		},
+		"labels no longer configured are removed": {
+			Effective:  map[string]interface{}{"env": "prod", "team": "platform", "goog-managed": "true"},
+			OldManaged: map[string]interface{}{"env": "prod", "team": "platform"},
+			NewManaged: map[string]interface{}{"env": "prod"},
+			Expected:   map[string]interface{}{"env": "prod", "goog-managed": "true"},
+		},
+		"configured labels override the resource's": {
+			Effective:  map[string]interface{}{"env": "prod", "goog-managed": "true"},
+			OldManaged: map[string]interface{}{"env": "prod"},
+			NewManaged: map[string]interface{}{"env": "dev", "team": "platform"},
+			Expected:   map[string]interface{}{"env": "dev", "team": "platform", "goog-managed": "true"},
+		},
+		"new resource": {
+			Effective:  map[string]interface{}{},
+			OldManaged: map[string]interface{}{},
+			NewManaged: map[string]interface{}{"env": "prod"},
+			Expected:   map[string]interface{}{"env": "prod"},
+		},
+	}
+
+	for tn, tc := range cases {
+		got := mergeEffectiveLabels(tc.Effective, tc.OldManaged, tc.NewManaged)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestFlattenAnnotations(t *testing.T) {
+	d := &ResourceDataMock{
+		FieldsInSchema: map[string]interface{}{
+			"annotations": map[string]interface{}{"owner": "me"},
+		},
+	}
+	got := flattenAnnotations(map[string]string{"owner": "me", "run.googleapis.com/ingress": "all"}, d)
+	expected := map[string]interface{}{"owner": "me"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
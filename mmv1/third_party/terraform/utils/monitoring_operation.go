@@ -0,0 +1,47 @@
+package google
+
+import (
+	"fmt"
+	"time"
+)
+
+type MonitoringOperationWaiter struct {
+	Config    *Config
+	UserAgent string
+	Project   string
+	CommonOperationWaiter
+}
+
+func (w *MonitoringOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	// Returns the proper get.
+	url := fmt.Sprintf("%sv1/%s", w.Config.MonitoringBasePath, w.CommonOperationWaiter.Op.Name)
+
+	return sendRequest(w.Config, "GET", w.Project, url, w.UserAgent, nil)
+}
+
+func createMonitoringWaiter(config *Config, op map[string]interface{}, project, activity, userAgent string) (*MonitoringOperationWaiter, error) {
+	w := &MonitoringOperationWaiter{
+		Config:    config,
+		UserAgent: userAgent,
+		Project:   project,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func monitoringOperationWaitTime(config *Config, op map[string]interface{}, project, activity, userAgent string, timeout time.Duration) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w, err := createMonitoringWaiter(config, op, project, activity, userAgent)
+	if err != nil {
+		return err
+	}
+	return OperationWait(w, activity, timeout, config.PollInterval)
+}
@@ -0,0 +1,42 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+const NESTED_PATCH_FINGERPRINT_RETRIES = 10
+
+// The delay before rereading the parent resource grows by this much after
+// every stale request, to give concurrent writers time to finish.
+var nestedPatchRetryBackoff = time.Second
+
+// Resources nested in a list of a parent resource are modified by sending the
+// whole list, along with the parent's fingerprint. As the parent uses
+// optimistic locking, the request is rejected if another client modified the
+// parent since the list was read. NestedPatchRetryWrapper then calls reread
+// to rebuild the request from the parent's current state, and sends it again
+// after a short backoff.
+func NestedPatchRetryWrapper(send func() error, reread func() error) error {
+	attempt := 0
+	for attempt < NESTED_PATCH_FINGERPRINT_RETRIES {
+		err := send()
+		if err == nil {
+			return nil
+		}
+
+		if ok, _ := isStaleFingerprintError(err); !ok {
+			// Something else went wrong, don't retry
+			return err
+		}
+
+		log.Printf("[DEBUG] Dismissed an error as retryable as the parent resource was modified concurrently: %s", err)
+		time.Sleep(time.Duration(attempt+1) * nestedPatchRetryBackoff)
+		if err := reread(); err != nil {
+			return err
+		}
+		attempt++
+	}
+	return fmt.Errorf("Failed to modify the parent resource after %d retries", attempt)
+}
@@ -0,0 +1,79 @@
+package google
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestNestedPatchRetryWrapper(t *testing.T) {
+	nestedPatchRetryBackoff = 0
+	defer func() { nestedPatchRetryBackoff = time.Second }()
+
+	stale := &googleapi.Error{Code: 412}
+	cases := map[string]struct {
+		SendErrors    []error
+		RereadErr     error
+		ExpectError   bool
+		ExpectSends   int
+		ExpectRereads int
+	}{
+		"success": {
+			SendErrors:  []error{nil},
+			ExpectSends: 1,
+		},
+		"success after stale fingerprints": {
+			SendErrors:    []error{stale, stale, nil},
+			ExpectSends:   3,
+			ExpectRereads: 2,
+		},
+		"other error not retried": {
+			SendErrors:  []error{&googleapi.Error{Code: 400}},
+			ExpectError: true,
+			ExpectSends: 1,
+		},
+		"reread error": {
+			SendErrors:    []error{stale},
+			RereadErr:     errors.New("reread failed"),
+			ExpectError:   true,
+			ExpectSends:   1,
+			ExpectRereads: 1,
+		},
+		"retries exhausted": {
+			ExpectError:   true,
+			ExpectSends:   NESTED_PATCH_FINGERPRINT_RETRIES,
+			ExpectRereads: NESTED_PATCH_FINGERPRINT_RETRIES,
+		},
+	}
+
+	for tn, tc := range cases {
+		sends, rereads := 0, 0
+		send := func() error {
+			sends++
+			if sends > len(tc.SendErrors) {
+				return stale
+			}
+			return tc.SendErrors[sends-1]
+		}
+		reread := func() error {
+			rereads++
+			return tc.RereadErr
+		}
+
+		err := NestedPatchRetryWrapper(send, reread)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: expected no error, got %s", tn, err)
+		}
+		if sends != tc.ExpectSends {
+			t.Errorf("%s: expected %d sends, got %d", tn, tc.ExpectSends, sends)
+		}
+		if rereads != tc.ExpectRereads {
+			t.Errorf("%s: expected %d rereads, got %d", tn, tc.ExpectRereads, rereads)
+		}
+	}
+}
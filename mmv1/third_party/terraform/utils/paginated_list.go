@@ -0,0 +1,52 @@
+package google
+
+import (
+	"errors"
+	"time"
+)
+
+// errStopPagination can be returned by the onPage function of
+// sendPaginatedRequest to stop reading pages without failing.
+var errStopPagination = errors.New("stop pagination")
+
+// sendPaginatedRequest sends requests to a list endpoint, calling onPage with
+// the response of each page until there is no nextPageToken left. params are
+// added to the query of every page request. It stops at the first error,
+// including the ones returned by onPage other than errStopPagination.
+func sendPaginatedRequest(config *Config, method, project, rawurl, userAgent string, params map[string]string, timeout time.Duration, onPage func(res map[string]interface{}) error, errorRetryPredicates ...RetryErrorPredicateFunc) error {
+	pageParams := make(map[string]string)
+	for k, v := range params {
+		pageParams[k] = v
+	}
+
+	for {
+		pageUrl, err := addQueryParams(rawurl, pageParams)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, method, project, pageUrl, userAgent, nil, timeout, errorRetryPredicates...)
+		if err != nil {
+			return err
+		}
+
+		if err := onPage(res); err != nil {
+			if err == errStopPagination {
+				return nil
+			}
+			return err
+		}
+
+		pToken, ok := res["nextPageToken"].(string)
+		if !ok || pToken == "" {
+			return nil
+		}
+		pageParams["pageToken"] = pToken
+	}
+}
+
+// listPaginatedItems reads every page of a list endpoint with GET requests,
+// see sendPaginatedRequest.
+func listPaginatedItems(config *Config, project, rawurl, userAgent string, params map[string]string, onPage func(res map[string]interface{}) error, errorRetryPredicates ...RetryErrorPredicateFunc) error {
+	return sendPaginatedRequest(config, "GET", project, rawurl, userAgent, params, DefaultRequestTimeout, onPage, errorRetryPredicates...)
+}
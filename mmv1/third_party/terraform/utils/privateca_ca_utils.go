@@ -58,6 +58,21 @@ func disableCA(config *Config, d *schema.ResourceData, project string, billingPr
 	return nil
 }
 
+// fetchSubCACsr returns the certificate signing request of a subordinate CA.
+// It is only available while the CA is in the AWAITING_USER_ACTIVATION state.
+func fetchSubCACsr(config *Config, d *schema.ResourceData, billingProject string, userAgent string) (string, error) {
+	fetchCSRUrl, err := replaceVars(d, config, "{{PrivatecaBasePath}}projects/{{project}}/locations/{{location}}/caPools/{{pool}}/certificateAuthorities/{{certificate_authority_id}}:fetch")
+	if err != nil {
+		return "", err
+	}
+	res, err := sendRequest(config, "GET", billingProject, fetchCSRUrl, userAgent, nil)
+	if err != nil {
+		return "", err
+	}
+	csr, _ := res["pemCsr"].(string)
+	return csr, nil
+}
+
 func activateSubCAWithThirdPartyIssuer(config *Config, d *schema.ResourceData, project string, billingProject string, userAgent string) error {
 	// 1. prepare parameters
 	signedCACert := d.Get("pem_ca_certificate").(string)
@@ -129,15 +144,10 @@ func activateSubCAWithFirstPartyIssuer(config *Config, d *schema.ResourceData, p
 	issuer := ca.(string)
 
 	// 2. fetch CSR
-	fetchCSRUrl, err := replaceVars(d, config, "{{PrivatecaBasePath}}projects/{{project}}/locations/{{location}}/caPools/{{pool}}/certificateAuthorities/{{certificate_authority_id}}:fetch")
-	if err != nil {
-		return err
-	}
-	res, err := sendRequest(config, "GET", billingProject, fetchCSRUrl, userAgent, nil)
+	csr, err := fetchSubCACsr(config, d, billingProject, userAgent)
 	if err != nil {
 		return fmt.Errorf("failed to fetch CSR: %v", err)
 	}
-	csr := res["pemCsr"]
 
 	// 3. sign the CSR with first party issuer
 	genCertId := func() string {
@@ -187,7 +197,7 @@ func activateSubCAWithFirstPartyIssuer(config *Config, d *schema.ResourceData, p
 	}
 
 	log.Printf("[DEBUG] Signing CA Certificate: %#v", obj)
-	res, err = sendRequestWithTimeout(config, "POST", billingProject, signUrl, userAgent, obj, d.Timeout(schema.TimeoutCreate))
+	res, err := sendRequestWithTimeout(config, "POST", billingProject, signUrl, userAgent, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Certificate: %s", err)
 	}
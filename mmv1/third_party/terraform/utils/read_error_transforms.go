@@ -0,0 +1,72 @@
+package google
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+)
+
+// Messages returned by GCP APIs when reading a child resource whose parent was
+// deleted out of band. APIs don't consistently return a 404 in that case, and
+// instead fail with a 400, 403 or 409, which makes refresh fail hard instead of
+// removing the child from state.
+var parentNotFoundErrorPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)parent (resource )?(was )?not found`),
+	regexp.MustCompile(`(?i)parent (resource )?(does not exist|has been deleted|was deleted)`),
+	regexp.MustCompile(`(?i)the resource '[^']+' (was not found|has been deleted)`),
+}
+
+// Error reasons that indicate the resource (or one of its parents) is gone,
+// even when the HTTP status code isn't a 404.
+var parentNotFoundErrorReasons = []string{
+	"notFound",
+	"resourceNotFound",
+}
+
+// isParentNotFoundError returns true if err indicates the resource is gone
+// because its parent was deleted.
+func isParentNotFoundError(err error) bool {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return false
+	}
+
+	if gerr.Code != 400 && gerr.Code != 403 && gerr.Code != 409 {
+		return false
+	}
+
+	for _, e := range gerr.Errors {
+		for _, reason := range parentNotFoundErrorReasons {
+			if e.Reason == reason {
+				return true
+			}
+		}
+	}
+
+	for _, pattern := range parentNotFoundErrorPatterns {
+		if pattern.MatchString(gerr.Message) || pattern.MatchString(gerr.Body) {
+			return true
+		}
+	}
+	return false
+}
+
+// transformParentNotFoundReadError modifies the code of errors returned when the
+// parent of a resource was deleted to 404 so that handleNotFoundError(...)
+// removes the resource from state.
+//
+// Generated resources opt in to this behaviour with `read_error_parent_not_found`
+// in terraform.yaml, handwritten ones can wrap the error passed to
+// handleNotFoundError(...) directly.
+func transformParentNotFoundReadError(err error) error {
+	if !isParentNotFoundError(err) {
+		return err
+	}
+
+	gerr := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	log.Printf("[DEBUG] Transformed parent not found error with code %d to 404: %s", gerr.Code, gerr.Message)
+	gerr.Code = 404
+	return gerr
+}
@@ -0,0 +1,102 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+)
+
+func TestTransformParentNotFoundReadError(t *testing.T) {
+	cases := map[string]struct {
+		err          error
+		expectedCode int
+	}{
+		"parent not found message": {
+			err: &googleapi.Error{
+				Code:    400,
+				Message: "Parent resource not found: projects/p/locations/us-central1/clusters/c",
+			},
+			expectedCode: 404,
+		},
+		"parent deleted message": {
+			err: &googleapi.Error{
+				Code:    409,
+				Message: "The parent resource has been deleted.",
+			},
+			expectedCode: 404,
+		},
+		"compute resource was not found": {
+			err: &googleapi.Error{
+				Code:    400,
+				Message: "The resource 'projects/p/zones/us-central1-a/instanceGroupManagers/igm' was not found",
+			},
+			expectedCode: 404,
+		},
+		"not found reason": {
+			err: &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "notFound"}},
+			},
+			expectedCode: 404,
+		},
+		"stopped sql instance isn't gone": {
+			err: &googleapi.Error{
+				Code:    400,
+				Message: "Invalid request since instance is not running.",
+			},
+			expectedCode: 400,
+		},
+		"wrapped": {
+			err: errwrap.Wrapf("Error reading: {{err}}", &googleapi.Error{
+				Code:    400,
+				Message: "Parent resource not found",
+			}),
+			expectedCode: 404,
+		},
+		"unrelated bad request": {
+			err: &googleapi.Error{
+				Code:    400,
+				Message: "Invalid value for field 'resource.name'",
+			},
+			expectedCode: 400,
+		},
+		"permission denied": {
+			err: &googleapi.Error{
+				Code:    403,
+				Message: "The caller does not have permission",
+				Errors:  []googleapi.ErrorItem{{Reason: "forbidden"}},
+			},
+			expectedCode: 403,
+		},
+		"server error mentioning parent": {
+			err: &googleapi.Error{
+				Code:    500,
+				Message: "Parent resource not found",
+			},
+			expectedCode: 500,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := transformParentNotFoundReadError(tc.err)
+		if code := errorCode(err); code != tc.expectedCode {
+			t.Errorf("%s: expected code %d, got %d", tn, tc.expectedCode, code)
+		}
+	}
+}
+
+func TestTransformParentNotFoundReadError_nonGoogleApiError(t *testing.T) {
+	err := fmt.Errorf("parent resource not found")
+	if transformParentNotFoundReadError(err) != err {
+		t.Errorf("expected non-googleapi error to be returned unchanged")
+	}
+}
+
+func errorCode(err error) int {
+	if gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error); ok && gerr != nil {
+		return gerr.Code
+	}
+	return 0
+}
@@ -0,0 +1,100 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// resourceImportLister lists the existing instances of a resource type in a
+// project. It returns their import IDs keyed by a name that is unique within
+// the project and stable across runs, suitable as a for_each key.
+type resourceImportLister func(config *Config, project, userAgent string) (map[string]string, error)
+
+// resourceImportListers are the resource types that can be adopted in bulk
+// through the google_importable_resources data source.
+var resourceImportListers = map[string]resourceImportLister{
+	"google_compute_instance": listComputeInstanceImportIds,
+	"google_service_account":  listServiceAccountImportIds,
+	"google_storage_bucket":   listStorageBucketImportIds,
+}
+
+func resourceImportListerTypes() []string {
+	types := make([]string, 0, len(resourceImportListers))
+	for t := range resourceImportListers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func listStorageBucketImportIds(config *Config, project, userAgent string) (map[string]string, error) {
+	url := fmt.Sprintf("%sb", config.StorageBasePath)
+
+	ids := make(map[string]string)
+	err := listPaginatedItems(config, project, url, userAgent, map[string]string{"project": project}, func(res map[string]interface{}) error {
+		items, _ := res["items"].([]interface{})
+		for _, raw := range items {
+			if name, ok := raw.(map[string]interface{})["name"].(string); ok {
+				ids[name] = name
+			}
+		}
+		return nil
+	})
+	return ids, err
+}
+
+func listComputeInstanceImportIds(config *Config, project, userAgent string) (map[string]string, error) {
+	url := fmt.Sprintf("%sprojects/%s/aggregated/instances", config.ComputeBasePath, project)
+
+	instances, err := sendAggregatedListRequest(config, project, url, userAgent, "instances", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	for _, instance := range instances {
+		name, _ := instance.Item["name"].(string)
+		zone := instance.ScopeName()
+		ids[fmt.Sprintf("%s/%s", zone, name)] = fmt.Sprintf("projects/%s/zones/%s/instances/%s", project, zone, name)
+	}
+	return ids, nil
+}
+
+func listServiceAccountImportIds(config *Config, project, userAgent string) (map[string]string, error) {
+	url := fmt.Sprintf("%sprojects/%s/serviceAccounts", config.IAMBasePath, project)
+
+	ids := make(map[string]string)
+	err := listPaginatedItems(config, project, url, userAgent, nil, func(res map[string]interface{}) error {
+		accounts, _ := res["accounts"].([]interface{})
+		for _, raw := range accounts {
+			account := raw.(map[string]interface{})
+			name, _ := account["name"].(string)
+			email, _ := account["email"].(string)
+			ids[email] = name
+		}
+		return nil
+	})
+	return ids, err
+}
+
+var importBlockAddressKeyRegex = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+
+// renderImportBlocks renders one Terraform import block per import ID, to be
+// written to a file and used with `terraform plan -generate-config-out`.
+func renderImportBlocks(resourceType string, ids map[string]string) string {
+	keys := make([]string, 0, len(ids))
+	for k := range ids {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var blocks []string
+	for _, k := range keys {
+		// Resource names must start with a letter or underscore
+		name := "r_" + strings.Trim(importBlockAddressKeyRegex.ReplaceAllString(k, "_"), "_")
+		blocks = append(blocks, fmt.Sprintf("import {\n  to = %s.%s\n  id = %q\n}\n", resourceType, name, ids[k]))
+	}
+	return strings.Join(blocks, "\n")
+}
@@ -0,0 +1,43 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestRenderImportBlocks(t *testing.T) {
+	ids := map[string]string{
+		"us-central1-a/instance-1": "projects/my-project/zones/us-central1-a/instances/instance-1",
+		"europe-west1-b/web":       "projects/my-project/zones/europe-west1-b/instances/web",
+	}
+
+	expected := `import {
+  to = google_compute_instance.r_europe-west1-b_web
+  id = "projects/my-project/zones/europe-west1-b/instances/web"
+}
+
+import {
+  to = google_compute_instance.r_us-central1-a_instance-1
+  id = "projects/my-project/zones/us-central1-a/instances/instance-1"
+}
+`
+	if got := renderImportBlocks("google_compute_instance", ids); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	if got := renderImportBlocks("google_storage_bucket", map[string]string{}); got != "" {
+		t.Errorf("expected no import blocks, got:\n%s", got)
+	}
+}
+
+func TestResourceImportListerTypes(t *testing.T) {
+	types := resourceImportListerTypes()
+	expected := []string{"google_compute_instance", "google_service_account", "google_storage_bucket"}
+	if len(types) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, types)
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, types)
+		}
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -46,6 +47,10 @@ import (
 
 const defaultRetryTransportTimeoutSec = 90
 
+// maxRetryTransportDelay caps the delay requested by the server through
+// Retry-After headers or RetryInfo error details.
+const maxRetryTransportDelay = 5 * time.Minute
+
 // NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors
 func NewTransportWithDefaultRetries(t http.RoundTripper) *retryTransport {
 	return &retryTransport{
@@ -71,9 +76,20 @@ func (t *retryTransport) WithAddedPredicates(predicates ...RetryErrorPredicateFu
 	return &copyT
 }
 
+// Returns a shallow copy of the retry transport that retries a request for at
+// most the given total time. A zero timeout uses the default.
+func (t *retryTransport) WithTimeout(timeout time.Duration) *retryTransport {
+	copyT := *t
+	copyT.timeout = timeout
+	return &copyT
+}
+
 type retryTransport struct {
 	retryPredicates []RetryErrorPredicateFunc
 	internal        http.RoundTripper
+	// timeout is the total time spent retrying a request if the request
+	// context has no deadline. Defaults to defaultRetryTransportTimeoutSec.
+	timeout time.Duration
 }
 
 // RoundTrip implements the RoundTripper interface method.
@@ -84,7 +100,11 @@ func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, resp
 	ctx := req.Context()
 	var ccancel context.CancelFunc
 	if _, ok := ctx.Deadline(); !ok {
-		ctx, ccancel = context.WithTimeout(ctx, defaultRetryTransportTimeoutSec*time.Second)
+		timeout := t.timeout
+		if timeout == 0 {
+			timeout = defaultRetryTransportTimeoutSec * time.Second
+		}
+		ctx, ccancel = context.WithTimeout(ctx, timeout)
 		defer func() {
 			if ctx.Err() == nil {
 				// Cleanup child context created for retry loop if ctx not done.
@@ -134,13 +154,24 @@ Retry:
 			break Retry
 		}
 
-		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
+		// Honor the delay requested by the server if it is longer than our
+		// backoff, but don't wait past the deadline just to fail anyway.
+		wait := backoff
+		if delay := retryDelayFromError(retryErr.Err); delay > wait {
+			wait = delay
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+				log.Printf("[DEBUG] Retry Transport: Stopping retries, server requested delay %s exceeds the remaining retry time", wait)
+				break Retry
+			}
+		}
+
+		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", wait)
 		select {
 		case <-ctx.Done():
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, context done: %v", ctx.Err())
 			break Retry
-		case <-time.After(backoff):
-			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", backoff)
+		case <-time.After(wait):
+			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", wait)
 
 			// Fibonnaci backoff - 0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ...
 			lastBackoff := backoff
@@ -209,3 +240,43 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 	}
 	return resource.NonRetryableError(errToCheck)
 }
+
+// retryDelayFromError returns the delay the server requested before retrying,
+// from either a Retry-After header or a google.rpc.RetryInfo error detail, or
+// zero if the server didn't request one.
+func retryDelayFromError(err error) time.Duration {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		return 0
+	}
+
+	var delay time.Duration
+	if v := gerr.Header.Get("Retry-After"); v != "" {
+		// Retry-After is either a number of seconds or an HTTP date.
+		if seconds, err := strconv.Atoi(v); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(v); err == nil {
+			delay = time.Until(date)
+		}
+	}
+
+	for _, d := range gerr.Details {
+		detail, ok := d.(map[string]interface{})
+		if !ok || detail["@type"] != "type.googleapis.com/google.rpc.RetryInfo" {
+			continue
+		}
+		if v, ok := detail["retryDelay"].(string); ok {
+			if retryDelay, err := time.ParseDuration(v); err == nil && retryDelay > delay {
+				delay = retryDelay
+			}
+		}
+	}
+
+	if delay < 0 {
+		return 0
+	}
+	if delay > maxRetryTransportDelay {
+		return maxRetryTransportDelay
+	}
+	return delay
+}
@@ -168,6 +168,111 @@ func TestRetryTransport_DoesNotRetryEmptyGetBody(t *testing.T) {
 	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
 }
 
+// Check that the transport waits for the delay requested by a Retry-After header
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	ts, client := setUpRetryTransportServerClient(
+		// Request asks for a 2 second delay before succeeding
+		testRetryTransportHandler_retryAfter(t, "2", testRetryTransportCodeSuccess))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*5)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	testRetryTransport_checkSuccess(t, resp, err)
+	if elapsed := time.Since(start); elapsed < time.Second*2 {
+		t.Errorf("expected to wait at least 2s before retrying, waited %s", elapsed)
+	}
+}
+
+// Check that the transport stops retrying if the requested delay exceeds the deadline
+func TestRetryTransport_RetryAfterExceedsDeadline(t *testing.T) {
+	ts, client := setUpRetryTransportServerClient(
+		// Request asks for a delay longer than the context timeout
+		testRetryTransportHandler_retryAfter(t, "10", testRetryTransportCodeSuccess))
+	defer ts.Close()
+
+	ctx, cc := context.WithTimeout(context.Background(), time.Second*2)
+	defer cc()
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL, nil)
+	if err != nil {
+		t.Fatalf("unable to construct err: %v", err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to stop retrying immediately, waited %s", elapsed)
+	}
+}
+
+func TestRetryDelayFromError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected time.Duration
+	}{
+		"not a googleapi error": {
+			Err:      fmt.Errorf("some error"),
+			Expected: 0,
+		},
+		"no delay": {
+			Err:      &googleapi.Error{Code: 429},
+			Expected: 0,
+		},
+		"retry-after seconds": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"30"}},
+			},
+			Expected: 30 * time.Second,
+		},
+		"retry info": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "12.5s",
+					},
+				},
+			},
+			Expected: 12500 * time.Millisecond,
+		},
+		"longest delay wins": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"5"}},
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "20s",
+					},
+				},
+			},
+			Expected: 20 * time.Second,
+		},
+		"capped delay": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"86400"}},
+			},
+			Expected: maxRetryTransportDelay,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := retryDelayFromError(tc.Err); got != tc.Expected {
+			t.Errorf("%s: expected delay %s, got %s", tn, tc.Expected, got)
+		}
+	}
+}
+
 // handlers
 func testRetryTransportHandler_noRetries(t *testing.T, code int) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -217,6 +322,33 @@ func testRetryTransportHandler_returnAfter(t *testing.T, interval time.Duration,
 	})
 }
 
+// Returns a retryable error with the given Retry-After header on the first
+// request, and the given code afterwards.
+func testRetryTransportHandler_retryAfter(t *testing.T, retryAfter string, code int) http.Handler {
+	var attempted bool
+	var mu sync.Mutex
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !attempted {
+			attempted = true
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(testRetryTransportCodeRetry)
+			if _, err := w.Write([]byte(fmt.Sprintf("Code: %d", testRetryTransportCodeRetry))); err != nil {
+				t.Errorf("[ERROR] unable to write to response writer: %v", err)
+			}
+			return
+		}
+
+		w.WriteHeader(code)
+		if _, err := w.Write([]byte(fmt.Sprintf("Code: %d", code))); err != nil {
+			t.Errorf("[ERROR] unable to write to response writer: %v", err)
+		}
+	})
+}
+
 // Utils for checking
 func testRetryTransport_checkSuccess(t *testing.T, resp *http.Response, respErr error) {
 	if respErr != nil {
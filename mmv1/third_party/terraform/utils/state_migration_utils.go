@@ -0,0 +1,28 @@
+package google
+
+import "log"
+
+// renameStateFields moves the values of top-level fields that were renamed in
+// the schema from their old key to their new one. renames maps each old field
+// name to its new name. Values already stored under the new name are kept, so
+// the upgrade is safe to run on state that was partially migrated by hand.
+//
+// Moving the value rather than dropping it keeps renamed ForceNew fields from
+// producing a diff, and so from recreating the resource, on the first plan
+// after the upgrade.
+func renameStateFields(rawState map[string]interface{}, renames map[string]string) map[string]interface{} {
+	for oldName, newName := range renames {
+		v, ok := rawState[oldName]
+		if !ok {
+			continue
+		}
+		delete(rawState, oldName)
+
+		if _, ok := rawState[newName]; ok {
+			log.Printf("[DEBUG] Field %q is already set, discarding the value of renamed field %q", newName, oldName)
+			continue
+		}
+		rawState[newName] = v
+	}
+	return rawState
+}
@@ -0,0 +1,150 @@
+package google
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRenameStateFields(t *testing.T) {
+	cases := map[string]struct {
+		rawState map[string]interface{}
+		renames  map[string]string
+		expected map[string]interface{}
+	}{
+		"renamed field": {
+			rawState: map[string]interface{}{"old_name": "foo", "other": "bar"},
+			renames:  map[string]string{"old_name": "new_name"},
+			expected: map[string]interface{}{"new_name": "foo", "other": "bar"},
+		},
+		"nested value": {
+			rawState: map[string]interface{}{"old_block": []interface{}{map[string]interface{}{"key": "value"}}},
+			renames:  map[string]string{"old_block": "new_block"},
+			expected: map[string]interface{}{"new_block": []interface{}{map[string]interface{}{"key": "value"}}},
+		},
+		"multiple renames": {
+			rawState: map[string]interface{}{"a": "1", "b": "2"},
+			renames:  map[string]string{"a": "x", "b": "y"},
+			expected: map[string]interface{}{"x": "1", "y": "2"},
+		},
+		"old field missing": {
+			rawState: map[string]interface{}{"other": "bar"},
+			renames:  map[string]string{"old_name": "new_name"},
+			expected: map[string]interface{}{"other": "bar"},
+		},
+		"new field already set": {
+			rawState: map[string]interface{}{"old_name": "foo", "new_name": "baz"},
+			renames:  map[string]string{"old_name": "new_name"},
+			expected: map[string]interface{}{"new_name": "baz"},
+		},
+	}
+
+	for tn, tc := range cases {
+		actual := renameStateFields(tc.rawState, tc.renames)
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %#v, got %#v", tn, tc.expected, actual)
+		}
+	}
+}
+
+// testRenamedFieldResource is shaped like the resources generated for
+// properties setting renamed_from: old_name was renamed to new_name, and the
+// V0 schema still has the old name.
+func testRenamedFieldResource() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type: (&schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"old_name": {Type: schema.TypeString, Required: true, ForceNew: true},
+						"other":    {Type: schema.TypeString, Optional: true},
+					},
+				}).CoreConfigSchema().ImpliedType(),
+				Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+					return renameStateFields(rawState, map[string]string{"old_name": "new_name"}), nil
+				},
+				Version: 0,
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"new_name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			"other":    {Type: schema.TypeString, Optional: true},
+		},
+		Create: schema.Noop,
+		Read:   schema.Noop,
+		Delete: schema.Noop,
+	}
+}
+
+func TestRenameStateFields_stateUpgrade(t *testing.T) {
+	ctx := context.Background()
+	server := (&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"google_renamed_field_thing": testRenamedFieldResource(),
+		},
+	}).GRPCProvider()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("error getting the provider schema: %s", err)
+	}
+	typ := schemaResp.ResourceSchemas["google_renamed_field_thing"].ValueType()
+
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "google_renamed_field_thing",
+		Version:  0,
+		RawState: &tfprotov5.RawState{
+			JSON: []byte(`{"id":"thing","old_name":"foo","other":"bar"}`),
+		},
+	})
+	if err != nil {
+		t.Fatalf("error upgrading state: %s", err)
+	}
+	for _, diag := range upgradeResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic upgrading state: %s: %s", diag.Summary, diag.Detail)
+	}
+
+	upgraded, err := upgradeResp.UpgradedState.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("error decoding the upgraded state: %s", err)
+	}
+	expected := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "thing"),
+		"new_name": tftypes.NewValue(tftypes.String, "foo"),
+		"other":    tftypes.NewValue(tftypes.String, "bar"),
+	})
+	if !upgraded.Equal(expected) {
+		t.Fatalf("expected upgraded state %s, got %s", expected, upgraded)
+	}
+
+	// The renamed field is ForceNew, planning the same value under its new
+	// name must not replace the resource.
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, nil),
+		"new_name": tftypes.NewValue(tftypes.String, "foo"),
+		"other":    tftypes.NewValue(tftypes.String, "bar"),
+	}))
+	if err != nil {
+		t.Fatalf("error encoding the config: %s", err)
+	}
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "google_renamed_field_thing",
+		PriorState:       upgradeResp.UpgradedState,
+		ProposedNewState: upgradeResp.UpgradedState,
+		Config:           &config,
+	})
+	if err != nil {
+		t.Fatalf("error planning the upgraded resource: %s", err)
+	}
+	for _, diag := range planResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic planning the upgraded resource: %s: %s", diag.Summary, diag.Detail)
+	}
+	if len(planResp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement after the upgrade, got %v", planResp.RequiresReplace)
+	}
+}
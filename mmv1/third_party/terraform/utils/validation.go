@@ -355,3 +355,91 @@ func validateADDomainName() schema.SchemaValidateFunc {
 		return
 	}
 }
+
+type cronFieldRange struct {
+	name     string
+	min, max int
+	// Names that may be used instead of numbers, starting at min.
+	names []string
+}
+
+var cronFieldRanges = []cronFieldRange{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+func (r cronFieldRange) parse(value string) (int, error) {
+	for i, name := range r.names {
+		if strings.EqualFold(value, name) {
+			return r.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	if n < r.min || n > r.max {
+		return 0, fmt.Errorf("%d is not between %d and %d", n, r.min, r.max)
+	}
+	return n, nil
+}
+
+func (r cronFieldRange) validate(field string) error {
+	for _, part := range strings.Split(field, ",") {
+		values := part
+		if i := strings.Index(part, "/"); i >= 0 {
+			values = part[:i]
+			if step, err := strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return fmt.Errorf("%q is not a valid step", part[i+1:])
+			}
+		}
+		if values == "*" {
+			continue
+		}
+		bounds := strings.SplitN(values, "-", 2)
+		low, err := r.parse(bounds[0])
+		if err != nil {
+			return err
+		}
+		if len(bounds) == 2 {
+			high, err := r.parse(bounds[1])
+			if err != nil {
+				return err
+			}
+			if low > high {
+				return fmt.Errorf("range %q is reversed", values)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCronSchedule ensures that a field is a schedule in the unix-cron format
+// https://cloud.google.com/scheduler/docs/configuring/cron-job-schedules
+func validateCronSchedule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	fields := strings.Fields(value)
+	if len(fields) != len(cronFieldRanges) {
+		errors = append(errors, fmt.Errorf("%q must be a unix-cron schedule with %d fields, got: %s", k, len(cronFieldRanges), value))
+		return
+	}
+	for i, field := range fields {
+		r := cronFieldRanges[i]
+		if err := r.validate(field); err != nil {
+			errors = append(errors, fmt.Errorf("%q has an invalid %s field %q: %s", k, r.name, field, err))
+		}
+	}
+	return
+}
+
+// validateTimeZone ensures that a field is a time zone name from the IANA time zone database
+func validateTimeZone(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		errors = append(errors, fmt.Errorf("%q must be a time zone name from the IANA time zone database, such as America/New_York, got: %s", k, value))
+	}
+	return
+}
@@ -345,3 +345,48 @@ func TestValidateIAMCustomRoleIDRegex(t *testing.T) {
 		t.Errorf("Failed to validate IAMCustomRole IDs: %v", es)
 	}
 }
+
+func TestValidateCronSchedule(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "every minute", Value: "* * * * *"},
+		{TestName: "daily", Value: "0 7 * * *"},
+		{TestName: "steps and ranges", Value: "*/15 9-17 1,15 * MON-FRI"},
+		{TestName: "month names", Value: "0 0 1 JAN,jul *"},
+		{TestName: "sunday as 7", Value: "30 23 * * 7"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "too few fields", Value: "0 7 * *", ExpectError: true},
+		{TestName: "too many fields", Value: "0 0 7 * * *", ExpectError: true},
+		{TestName: "minute out of range", Value: "60 * * * *", ExpectError: true},
+		{TestName: "day of month out of range", Value: "0 0 0 * *", ExpectError: true},
+		{TestName: "reversed range", Value: "0 17-9 * * *", ExpectError: true},
+		{TestName: "invalid step", Value: "*/0 * * * *", ExpectError: true},
+		{TestName: "unknown name", Value: "0 0 * * FUN", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateCronSchedule)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate cron schedules: %v", es)
+	}
+}
+
+func TestValidateTimeZone(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "utc", Value: "UTC"},
+		{TestName: "region", Value: "America/New_York"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "local", Value: "Local", ExpectError: true},
+		{TestName: "unknown", Value: "Mars/Olympus_Mons", ExpectError: true},
+		{TestName: "offset", Value: "+02:00", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateTimeZone)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate time zones: %v", es)
+	}
+}
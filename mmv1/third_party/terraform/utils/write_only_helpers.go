@@ -0,0 +1,117 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Write-only fields are sent to the API but never stored in the state. The
+// SDK version used by the provider doesn't support them natively, so they're
+// emulated: the field is cleared from the state on read, diffs on it are
+// suppressed once the resource exists, and its value is read from the
+// configuration when it's sent. Changing the paired `_wo_version` field is
+// what triggers sending it again.
+
+// writeOnlyDiffSuppress ignores changes to a write-only field once the
+// resource exists, as the field is always empty in the state.
+func writeOnlyDiffSuppress(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// writeOnlyConfigValue returns the value of the write-only field at path
+// (e.g. "parent.0.password_wo") in the resource's configuration. v, the value
+// of the field in the plan, is returned if the configuration isn't available.
+func writeOnlyConfigValue(d TerraformResourceData, path string, v interface{}) (interface{}, error) {
+	rd, ok := d.(*schema.ResourceData)
+	if !ok {
+		return v, nil
+	}
+	if value, ok := writeOnlyValueAtPath(rd.GetRawConfig(), path); ok {
+		return value, nil
+	}
+	return v, nil
+}
+
+// writeOnlyValueAtPath returns the string at path in a configuration value,
+// and false if it isn't set or known.
+func writeOnlyValueAtPath(val cty.Value, path string) (string, bool) {
+	for _, step := range strings.Split(path, ".") {
+		if val.IsNull() || !val.IsKnown() {
+			return "", false
+		}
+		ty := val.Type()
+		if i, err := strconv.Atoi(step); err == nil {
+			if !(ty.IsListType() || ty.IsTupleType()) || i >= val.LengthInt() {
+				return "", false
+			}
+			val = val.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !ty.IsObjectType() || !ty.HasAttribute(step) {
+			return "", false
+		}
+		val = val.GetAttr(step)
+	}
+
+	if val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.String) {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// clearWriteOnlyField removes the value of the write-only field at path
+// (e.g. "parent.0.password_wo") from the state.
+func clearWriteOnlyField(d *schema.ResourceData, path string) error {
+	parts := strings.Split(path, ".")
+	if len(parts) == 1 {
+		return d.Set(path, nil)
+	}
+
+	v, ok := d.GetOk(parts[0])
+	if !ok {
+		return nil
+	}
+	cleared, changed := clearWriteOnlyValue(v, parts[1:])
+	if !changed {
+		return nil
+	}
+	if err := d.Set(parts[0], cleared); err != nil {
+		return fmt.Errorf("Error setting %s: %s", parts[0], err)
+	}
+	return nil
+}
+
+// clearWriteOnlyValue empties the string at path in a nested block value read
+// from the state, and reports whether it was set.
+func clearWriteOnlyValue(v interface{}, path []string) (interface{}, bool) {
+	switch t := v.(type) {
+	case []interface{}:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i >= len(t) {
+			return v, false
+		}
+		item, changed := clearWriteOnlyValue(t[i], path[1:])
+		if changed {
+			t[i] = item
+		}
+		return t, changed
+	case map[string]interface{}:
+		if len(path) == 1 {
+			if s, ok := t[path[0]].(string); !ok || s == "" {
+				return v, false
+			}
+			t[path[0]] = ""
+			return t, true
+		}
+		item, changed := clearWriteOnlyValue(t[path[0]], path[1:])
+		if changed {
+			t[path[0]] = item
+		}
+		return t, changed
+	}
+	return v, false
+}
@@ -0,0 +1,129 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriteOnlyValueAtPath(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"password_wo": cty.StringVal("top-secret"),
+		"unset_wo":    cty.NullVal(cty.String),
+		"unknown_wo":  cty.UnknownVal(cty.String),
+		"initial_user": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("nested-secret"),
+			}),
+		}),
+		"empty_block": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+			"password_wo": cty.String,
+		})),
+	})
+
+	cases := map[string]struct {
+		Path          string
+		ExpectedValue string
+		ExpectedOk    bool
+	}{
+		"top-level field": {
+			Path:          "password_wo",
+			ExpectedValue: "top-secret",
+			ExpectedOk:    true,
+		},
+		"nested field": {
+			Path:          "initial_user.0.password_wo",
+			ExpectedValue: "nested-secret",
+			ExpectedOk:    true,
+		},
+		"unset field": {
+			Path: "unset_wo",
+		},
+		"unknown field": {
+			Path: "unknown_wo",
+		},
+		"missing field": {
+			Path: "missing_wo",
+		},
+		"field in an unset block": {
+			Path: "empty_block.0.password_wo",
+		},
+	}
+
+	for tn, tc := range cases {
+		value, ok := writeOnlyValueAtPath(config, tc.Path)
+		if ok != tc.ExpectedOk {
+			t.Errorf("%s: expected ok to be %t, got %t", tn, tc.ExpectedOk, ok)
+		}
+		if value != tc.ExpectedValue {
+			t.Errorf("%s: expected value %q, got %q", tn, tc.ExpectedValue, value)
+		}
+	}
+
+	if _, ok := writeOnlyValueAtPath(cty.NullVal(config.Type()), "password_wo"); ok {
+		t.Errorf("expected no value in a null configuration")
+	}
+}
+
+func TestClearWriteOnlyField(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"password_wo": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"initial_user": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"password_wo": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"password_wo_version": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"password_wo": "top-secret",
+		"initial_user": []interface{}{
+			map[string]interface{}{
+				"user":                "postgres",
+				"password_wo":         "nested-secret",
+				"password_wo_version": 2,
+			},
+		},
+	})
+
+	for _, path := range []string{"password_wo", "initial_user.0.password_wo"} {
+		if err := clearWriteOnlyField(d, path); err != nil {
+			t.Fatalf("clearing %s: %s", path, err)
+		}
+		if v := d.Get(path).(string); v != "" {
+			t.Errorf("expected %s to be cleared, got %q", path, v)
+		}
+	}
+
+	if v := d.Get("initial_user.0.user").(string); v != "postgres" {
+		t.Errorf("expected initial_user.0.user to be kept, got %q", v)
+	}
+	if v := d.Get("initial_user.0.password_wo_version").(int); v != 2 {
+		t.Errorf("expected initial_user.0.password_wo_version to be kept, got %d", v)
+	}
+
+	empty := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	if err := clearWriteOnlyField(empty, "initial_user.0.password_wo"); err != nil {
+		t.Errorf("clearing a field of an unset block: %s", err)
+	}
+}
@@ -17,6 +17,10 @@ func compare() []string {
 	return compareResourceMaps(resourceMapOld, resourceMapNew)
 }
 
+func report() schemaReport {
+	return buildSchemaReport(oldProvider.ResourceMap(), newProvider.ResourceMap())
+}
+
 func compareResourceMaps(old, new map[string]*schema.Resource) []string {
 	messages := []string{}
 
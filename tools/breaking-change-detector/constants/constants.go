@@ -2,6 +2,7 @@ package constants
 
 const BreakingChangeRelativeLocation = "/.github/"
 const BreakingChangeFileName = "BREAKING_CHANGES.md"
+const SchemaChangeReportFileName = "SCHEMA_CHANGES.json"
 
 var providerUrls = map[string]string{
 	"google":      "https://github.com/hashicorp/terraform-provider-google/blob/main",
@@ -10,7 +10,8 @@ import (
 )
 
 var docMode = flag.Bool("docs", false, "Switches the mode from running the comparison to creating a markdown file detailing the breaking change rules")
-var providerFolder = flag.String("providerFolder", "", "The location of the provider folder to output documentation into.. if not provided the documentation will be output to console")
+var reportMode = flag.Bool("report", false, "Switches the mode from running the comparison to creating a JSON report of all schema changes between the provider versions")
+var providerFolder = flag.String("providerFolder", "", "The location of the provider folder to output documentation or the schema change report into.. if not provided the output will be printed to console")
 var providerVersion = flag.String("providerVersion", "google-beta", "The version of provider used, needed for documentation.")
 
 func main() {
@@ -19,6 +20,8 @@ func main() {
 
 	if *docMode {
 		docs.Generate(*providerFolder)
+	} else if *reportMode {
+		writeSchemaReport(report(), *providerFolder)
 	} else {
 		breakages := compare()
 		sort.Strings(breakages)
@@ -30,8 +33,11 @@ func main() {
 }
 
 func validateParameters() {
-	if *providerFolder != "" && !*docMode {
-		glog.Exitln("parameter -docs must be set when specifying -providerFolder")
+	if *docMode && *reportMode {
+		glog.Exitln("only one of -docs and -report can be set")
+	}
+	if *providerFolder != "" && !*docMode && !*reportMode {
+		glog.Exitln("parameter -docs or -report must be set when specifying -providerFolder")
 	}
 	if *providerVersion != "google" && *providerVersion != "google-beta" {
 		glog.Exitln("only google and google-beta are supported provider versions")
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/magic-modules/.ci/breaking-change-detector/constants"
+	"github.com/golang/glog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// schemaReport is a machine-readable summary of the schema changes between
+// two provider versions, meant for tooling that audits releases.
+type schemaReport struct {
+	ProviderVersion  string                           `json:"provider_version"`
+	ResourcesAdded   []string                         `json:"resources_added"`
+	ResourcesRemoved []string                         `json:"resources_removed"`
+	Resources        map[string]*resourceSchemaReport `json:"resources"`
+	UpgradeGuide     []upgradeGuideEntry              `json:"upgrade_guide"`
+}
+
+// upgradeGuideEntry describes a change users may need to act on when
+// upgrading, in the shape of an upgrade guide section.
+type upgradeGuideEntry struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field,omitempty"`
+	Change   string `json:"change"`
+	Heading  string `json:"heading"`
+}
+
+// resourceSchemaReport lists the field level changes of a resource present
+// in both provider versions. Nested fields are named by their dotted path.
+type resourceSchemaReport struct {
+	FieldsAdded     []string      `json:"fields_added,omitempty"`
+	FieldsRemoved   []string      `json:"fields_removed,omitempty"`
+	FieldsChanged   []fieldChange `json:"fields_changed,omitempty"`
+	BreakingChanges []string      `json:"breaking_changes,omitempty"`
+}
+
+type fieldChange struct {
+	Field     string      `json:"field"`
+	Attribute string      `json:"attribute"`
+	Old       interface{} `json:"old"`
+	New       interface{} `json:"new"`
+}
+
+// reportedAttributes are the field attributes compared between versions.
+var reportedAttributes = []struct {
+	name  string
+	value func(*schema.Schema) interface{}
+}{
+	{"type", func(s *schema.Schema) interface{} { return s.Type.String() }},
+	{"elem_type", func(s *schema.Schema) interface{} {
+		if elem, ok := s.Elem.(*schema.Schema); ok {
+			return elem.Type.String()
+		}
+		return nil
+	}},
+	{"required", func(s *schema.Schema) interface{} { return s.Required }},
+	{"optional", func(s *schema.Schema) interface{} { return s.Optional }},
+	{"computed", func(s *schema.Schema) interface{} { return s.Computed }},
+	{"force_new", func(s *schema.Schema) interface{} { return s.ForceNew }},
+	{"sensitive", func(s *schema.Schema) interface{} { return s.Sensitive }},
+	{"default", func(s *schema.Schema) interface{} { return s.Default }},
+	{"min_items", func(s *schema.Schema) interface{} { return s.MinItems }},
+	{"max_items", func(s *schema.Schema) interface{} { return s.MaxItems }},
+	{"deprecated", func(s *schema.Schema) interface{} { return s.Deprecated }},
+}
+
+func buildSchemaReport(old, new map[string]*schema.Resource) schemaReport {
+	report := schemaReport{
+		ProviderVersion:  *providerVersion,
+		ResourcesAdded:   []string{},
+		ResourcesRemoved: []string{},
+		Resources:        map[string]*resourceSchemaReport{},
+	}
+
+	for resourceName := range new {
+		if _, ok := old[resourceName]; !ok {
+			report.ResourcesAdded = append(report.ResourcesAdded, resourceName)
+		}
+	}
+	for resourceName, oldResource := range old {
+		newResource, ok := new[resourceName]
+		if !ok {
+			report.ResourcesRemoved = append(report.ResourcesRemoved, resourceName)
+			continue
+		}
+		if resourceReport := buildResourceSchemaReport(resourceName, oldResource.Schema, newResource.Schema); resourceReport != nil {
+			report.Resources[resourceName] = resourceReport
+		}
+	}
+	sort.Strings(report.ResourcesAdded)
+	sort.Strings(report.ResourcesRemoved)
+	report.UpgradeGuide = buildUpgradeGuide(report)
+
+	return report
+}
+
+// buildUpgradeGuide lists the removed resources and fields, and the field
+// changes that can require changes to configurations or cause recreations.
+func buildUpgradeGuide(report schemaReport) []upgradeGuideEntry {
+	entries := []upgradeGuideEntry{}
+	for _, resourceName := range report.ResourcesRemoved {
+		entries = append(entries, upgradeGuideEntry{
+			Resource: resourceName,
+			Change:   "resource_removed",
+			Heading:  fmt.Sprintf("Resource `%s` is removed", resourceName),
+		})
+	}
+
+	resourceNames := make([]string, 0, len(report.Resources))
+	for resourceName := range report.Resources {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	for _, resourceName := range resourceNames {
+		resourceReport := report.Resources[resourceName]
+		for _, fieldName := range resourceReport.FieldsRemoved {
+			entries = append(entries, upgradeGuideEntry{
+				Resource: resourceName,
+				Field:    fieldName,
+				Change:   "field_removed",
+				Heading:  fmt.Sprintf("`%s` is removed", fieldName),
+			})
+		}
+		for _, change := range resourceReport.FieldsChanged {
+			entry := upgradeGuideEntry{
+				Resource: resourceName,
+				Field:    change.Field,
+			}
+			switch {
+			case change.Attribute == "type" || change.Attribute == "elem_type":
+				entry.Change = "field_type_changed"
+				entry.Heading = fmt.Sprintf("`%s` changed type from %v to %v", change.Field, change.Old, change.New)
+			case change.Attribute == "required" && change.New == true:
+				entry.Change = "field_required"
+				entry.Heading = fmt.Sprintf("`%s` is now required", change.Field)
+			case change.Attribute == "force_new" && change.New == true:
+				entry.Change = "field_force_new"
+				entry.Heading = fmt.Sprintf("Changing `%s` now recreates the resource", change.Field)
+			case change.Attribute == "default":
+				entry.Change = "field_default_changed"
+				entry.Heading = fmt.Sprintf("`%s` default value changed from %v to %v", change.Field, change.Old, change.New)
+			default:
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// buildResourceSchemaReport returns nil if the resource schema is unchanged.
+func buildResourceSchemaReport(resourceName string, old, new map[string]*schema.Schema) *resourceSchemaReport {
+	report := &resourceSchemaReport{}
+	oldCompressed := flattenSchema(old)
+	newCompressed := flattenSchema(new)
+
+	for fieldName, field := range newCompressed {
+		oldField, ok := oldCompressed[fieldName]
+		if !ok {
+			report.FieldsAdded = append(report.FieldsAdded, fieldName)
+			continue
+		}
+		for _, attribute := range reportedAttributes {
+			oldValue, newValue := attribute.value(oldField), attribute.value(field)
+			if !reflect.DeepEqual(oldValue, newValue) {
+				report.FieldsChanged = append(report.FieldsChanged, fieldChange{
+					Field:     fieldName,
+					Attribute: attribute.name,
+					Old:       oldValue,
+					New:       newValue,
+				})
+			}
+		}
+	}
+	for fieldName := range oldCompressed {
+		if _, ok := newCompressed[fieldName]; !ok {
+			report.FieldsRemoved = append(report.FieldsRemoved, fieldName)
+		}
+	}
+
+	if len(report.FieldsAdded) == 0 && len(report.FieldsRemoved) == 0 && len(report.FieldsChanged) == 0 {
+		return nil
+	}
+
+	sort.Strings(report.FieldsAdded)
+	sort.Strings(report.FieldsRemoved)
+	sort.Slice(report.FieldsChanged, func(i, j int) bool {
+		if report.FieldsChanged[i].Field != report.FieldsChanged[j].Field {
+			return report.FieldsChanged[i].Field < report.FieldsChanged[j].Field
+		}
+		return report.FieldsChanged[i].Attribute < report.FieldsChanged[j].Attribute
+	})
+	report.BreakingChanges = compareResourceSchema(resourceName, old, new)
+	sort.Strings(report.BreakingChanges)
+
+	return report
+}
+
+// writeSchemaReport writes the report as JSON into outputPath, or to the
+// console if outputPath is empty.
+func writeSchemaReport(report schemaReport, outputPath string) {
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		glog.Exit(err)
+	}
+
+	if outputPath == "" {
+		fmt.Println(string(contents))
+		return
+	}
+
+	err = os.WriteFile(path.Join(outputPath, constants.SchemaChangeReportFileName), append(contents, '\n'), 0644)
+	if err != nil {
+		glog.Exit(err)
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSchemaReport(t *testing.T) {
+	old := map[string]*schema.Resource{
+		"google-x": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true},
+				"field-b": {Type: schema.TypeString, Optional: true},
+				"field-c": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"sub-field-1": {Type: schema.TypeInt, Optional: true},
+						},
+					},
+				},
+			},
+		},
+		"google-y": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		"google-removed": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true},
+			},
+		},
+	}
+	new := map[string]*schema.Resource{
+		"google-x": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"field-c": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"sub-field-1": {Type: schema.TypeInt, Required: true},
+							"sub-field-2": {Type: schema.TypeBool, Optional: true},
+						},
+					},
+				},
+			},
+		},
+		"google-y": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		"google-added": {
+			Schema: map[string]*schema.Schema{
+				"field-a": {Type: schema.TypeString, Optional: true},
+			},
+		},
+	}
+
+	report := buildSchemaReport(old, new)
+
+	if !reflect.DeepEqual(report.ResourcesAdded, []string{"google-added"}) {
+		t.Errorf("expected resources_added [google-added], got %v", report.ResourcesAdded)
+	}
+	if !reflect.DeepEqual(report.ResourcesRemoved, []string{"google-removed"}) {
+		t.Errorf("expected resources_removed [google-removed], got %v", report.ResourcesRemoved)
+	}
+	if _, ok := report.Resources["google-y"]; ok {
+		t.Errorf("expected no entry for the unchanged resource google-y")
+	}
+
+	resourceReport, ok := report.Resources["google-x"]
+	if !ok {
+		t.Fatalf("expected an entry for google-x")
+	}
+	if !reflect.DeepEqual(resourceReport.FieldsAdded, []string{"field-c.sub-field-2"}) {
+		t.Errorf("expected fields_added [field-c.sub-field-2], got %v", resourceReport.FieldsAdded)
+	}
+	if !reflect.DeepEqual(resourceReport.FieldsRemoved, []string{"field-b"}) {
+		t.Errorf("expected fields_removed [field-b], got %v", resourceReport.FieldsRemoved)
+	}
+	expectedChanges := []fieldChange{
+		{Field: "field-a", Attribute: "force_new", Old: false, New: true},
+		{Field: "field-c.sub-field-1", Attribute: "optional", Old: true, New: false},
+		{Field: "field-c.sub-field-1", Attribute: "required", Old: false, New: true},
+	}
+	if !reflect.DeepEqual(resourceReport.FieldsChanged, expectedChanges) {
+		t.Errorf("expected fields_changed %v, got %v", expectedChanges, resourceReport.FieldsChanged)
+	}
+	// removing field-b and sub-field-1 becoming required
+	if len(resourceReport.BreakingChanges) != 2 {
+		t.Errorf("expected 2 breaking changes, got %v", resourceReport.BreakingChanges)
+	}
+
+	expectedGuide := []upgradeGuideEntry{
+		{Resource: "google-removed", Change: "resource_removed", Heading: "Resource `google-removed` is removed"},
+		{Resource: "google-x", Field: "field-b", Change: "field_removed", Heading: "`field-b` is removed"},
+		{Resource: "google-x", Field: "field-a", Change: "field_force_new", Heading: "Changing `field-a` now recreates the resource"},
+		{Resource: "google-x", Field: "field-c.sub-field-1", Change: "field_required", Heading: "`field-c.sub-field-1` is now required"},
+	}
+	if !reflect.DeepEqual(report.UpgradeGuide, expectedGuide) {
+		t.Errorf("expected upgrade_guide %v, got %v", expectedGuide, report.UpgradeGuide)
+	}
+}